@@ -84,6 +84,19 @@ type AuthOptions struct {
 
 	// Scope determines the scoping of the authentication request.
 	Scope *AuthScope `json:"-"`
+
+	// Authentication through Application Credentials requires supplying the
+	// ApplicationCredentialSecret along with either the
+	// ApplicationCredentialID, in which case no user is needed at all, or the
+	// ApplicationCredentialName. As names are only unique per user, the name
+	// must come with the UserID, or with the Username and the DomainID or
+	// DomainName of the user.
+	// Application credentials are scoped to the project they were created in,
+	// so Scope, TenantID and TenantName are ignored when using them. They also
+	// take precedence over Password and TokenID.
+	ApplicationCredentialID     string `json:"-"`
+	ApplicationCredentialName   string `json:"-"`
+	ApplicationCredentialSecret string `json:"-"`
 }
 
 // AuthScope allows a created token to be limited to a specific domain or project.
@@ -142,7 +155,7 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 	type userReq struct {
 		ID       *string    `json:"id,omitempty"`
 		Name     *string    `json:"name,omitempty"`
-		Password string     `json:"password"`
+		Password *string    `json:"password,omitempty"`
 		Domain   *domainReq `json:"domain,omitempty"`
 	}
 
@@ -154,10 +167,18 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 		ID string `json:"id"`
 	}
 
+	type applicationCredentialReq struct {
+		ID     *string  `json:"id,omitempty"`
+		Name   *string  `json:"name,omitempty"`
+		User   *userReq `json:"user,omitempty"`
+		Secret *string  `json:"secret,omitempty"`
+	}
+
 	type identityReq struct {
-		Methods  []string     `json:"methods"`
-		Password *passwordReq `json:"password,omitempty"`
-		Token    *tokenReq    `json:"token,omitempty"`
+		Methods               []string                  `json:"methods"`
+		Password              *passwordReq              `json:"password,omitempty"`
+		Token                 *tokenReq                 `json:"token,omitempty"`
+		ApplicationCredential *applicationCredentialReq `json:"application_credential,omitempty"`
 	}
 
 	type authReq struct {
@@ -172,7 +193,48 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 	// if insufficient or incompatible information is present.
 	var req request
 
-	if opts.Password == "" {
+	if opts.ApplicationCredentialID != "" || opts.ApplicationCredentialName != "" {
+		// Application credentials take precedence over a password or a token,
+		// so that a password left over in the environment, such as
+		// OS_PASSWORD, is never sent in their place.
+		if opts.ApplicationCredentialSecret == "" {
+			return nil, ErrAppCredMissingSecret{}
+		}
+
+		if opts.ApplicationCredentialID != "" {
+			// Configure the request for ApplicationCredentialID authentication.
+			// The ID alone identifies the credential, so no user is needed.
+			req.Auth.Identity.Methods = []string{"application_credential"}
+			req.Auth.Identity.ApplicationCredential = &applicationCredentialReq{
+				ID:     &opts.ApplicationCredentialID,
+				Secret: &opts.ApplicationCredentialSecret,
+			}
+		} else {
+			// Configure the request for ApplicationCredentialName authentication.
+			// The name is only unique per user, so either a UserID or a Username
+			// qualified with a DomainID or DomainName must also be provided.
+			var user *userReq
+			switch {
+			case opts.UserID != "":
+				user = &userReq{ID: &opts.UserID}
+			case opts.Username == "":
+				return nil, ErrUsernameOrUserID{}
+			case opts.DomainID != "":
+				user = &userReq{Name: &opts.Username, Domain: &domainReq{ID: &opts.DomainID}}
+			case opts.DomainName != "":
+				user = &userReq{Name: &opts.Username, Domain: &domainReq{Name: &opts.DomainName}}
+			default:
+				return nil, ErrDomainIDOrDomainName{}
+			}
+
+			req.Auth.Identity.Methods = []string{"application_credential"}
+			req.Auth.Identity.ApplicationCredential = &applicationCredentialReq{
+				Name:   &opts.ApplicationCredentialName,
+				User:   user,
+				Secret: &opts.ApplicationCredentialSecret,
+			}
+		}
+	} else if opts.Password == "" {
 		if opts.TokenID != "" {
 			// Because we aren't using password authentication, it's an error to also provide any of the user-based authentication
 			// parameters.
@@ -227,7 +289,7 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 				req.Auth.Identity.Password = &passwordReq{
 					User: userReq{
 						Name:     &opts.Username,
-						Password: opts.Password,
+						Password: &opts.Password,
 						Domain:   &domainReq{ID: &opts.DomainID},
 					},
 				}
@@ -238,7 +300,7 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 				req.Auth.Identity.Password = &passwordReq{
 					User: userReq{
 						Name:     &opts.Username,
-						Password: opts.Password,
+						Password: &opts.Password,
 						Domain:   &domainReq{Name: &opts.DomainName},
 					},
 				}
@@ -256,7 +318,7 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 
 			// Configure the request for UserID and Password authentication.
 			req.Auth.Identity.Password = &passwordReq{
-				User: userReq{ID: &opts.UserID, Password: opts.Password},
+				User: userReq{ID: &opts.UserID, Password: &opts.Password},
 			}
 		}
 	}
@@ -274,6 +336,12 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 }
 
 func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
+	// Application credentials are already scoped to a project, and Keystone
+	// rejects token requests which try to scope them again.
+	if opts.ApplicationCredentialID != "" || opts.ApplicationCredentialName != "" {
+		return nil, nil
+	}
+
 	// For backwards compatibility.
 	// If AuthOptions.Scope was not set, try to determine it.
 	// This works well for common scenarios.
@@ -0,0 +1,197 @@
+package gophercloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Logger is the interface DebugLogger writes to. A *log.Logger satisfies it.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// DebugLogger is a RequestHook which logs every request and response issued
+// by a ProviderClient, including their headers and JSON bodies. Tokens,
+// passwords, secrets and private keys are redacted before they are logged.
+//
+// Example:
+//
+//	provider.RequestHooks = append(provider.RequestHooks,
+//		gophercloud.NewDebugLogger(log.New(os.Stderr, "", log.LstdFlags)))
+type DebugLogger struct {
+	Logger Logger
+}
+
+// NewDebugLogger returns a DebugLogger writing to logger.
+func NewDebugLogger(logger Logger) *DebugLogger {
+	return &DebugLogger{Logger: logger}
+}
+
+// redactedHeaders are the headers whose values are never logged.
+var redactedHeaders = map[string]bool{
+	"X-Auth-Token":    true,
+	"X-Subject-Token": true,
+	"X-Service-Token": true,
+	"Authorization":   true,
+}
+
+const redacted = "***"
+
+// BeforeRequest implements RequestHook.
+func (l *DebugLogger) BeforeRequest(info *RequestInfo) {
+	req := info.Request
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "OpenStack request: %s %s", req.Method, req.URL)
+	if info.ServiceType != "" {
+		fmt.Fprintf(&b, " (service: %s", info.ServiceType)
+		if info.Microversion != "" {
+			fmt.Fprintf(&b, ", microversion: %s", info.Microversion)
+		}
+		b.WriteString(")")
+	}
+	if info.Attempt > 1 {
+		fmt.Fprintf(&b, " attempt %d", info.Attempt)
+	}
+	writeHeaders(&b, req.Header)
+
+	if req.GetBody != nil && isJSON(req.Header) {
+		if body, err := req.GetBody(); err == nil {
+			content, err := ioutil.ReadAll(body)
+			body.Close()
+			if err == nil {
+				writeBody(&b, content)
+			}
+		}
+	}
+
+	l.Logger.Printf("%s", b.String())
+}
+
+// AfterResponse implements RequestHook. JSON response bodies are read in
+// full to be logged, and replaced with an in-memory copy.
+func (l *DebugLogger) AfterResponse(info *RequestInfo) {
+	req := info.Request
+
+	var b strings.Builder
+	if info.Err != nil {
+		fmt.Fprintf(&b, "OpenStack response: %s %s failed after %s: %s", req.Method, req.URL, info.Latency, info.Err)
+		l.Logger.Printf("%s", b.String())
+		return
+	}
+
+	resp := info.Response
+	fmt.Fprintf(&b, "OpenStack response: %s %s returned %s in %s", req.Method, req.URL, resp.Status, info.Latency)
+	if id := info.RequestID(); id != "" {
+		fmt.Fprintf(&b, " (request ID: %s)", id)
+	}
+	writeHeaders(&b, resp.Header)
+
+	if resp.Body != nil && isJSON(resp.Header) {
+		content, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(content))
+		if err == nil {
+			writeBody(&b, content)
+		}
+	}
+
+	l.Logger.Printf("%s", b.String())
+}
+
+func isJSON(h http.Header) bool {
+	return strings.HasPrefix(h.Get("Content-Type"), "application/json")
+}
+
+func writeHeaders(b *strings.Builder, h http.Header) {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := strings.Join(h[k], ", ")
+		if redactedHeaders[http.CanonicalHeaderKey(k)] {
+			v = redacted
+		}
+		fmt.Fprintf(b, "\n%s: %s", k, v)
+	}
+}
+
+func writeBody(b *strings.Builder, content []byte) {
+	if len(content) == 0 {
+		return
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(content, &v); err != nil {
+		// Do not log what cannot be redacted.
+		b.WriteString("\n\n<invalid JSON body omitted>")
+		return
+	}
+
+	out, err := json.MarshalIndent(RedactJSON(v), "", "  ")
+	if err != nil {
+		return
+	}
+	b.WriteString("\n\n")
+	b.Write(out)
+}
+
+// RedactJSON returns a copy of a decoded JSON document in which the values of
+// passwords, secrets, private keys and tokens are replaced with "***".
+func RedactJSON(v interface{}) interface{} {
+	return redactJSON("", v)
+}
+
+func redactJSON(parent string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			if isSensitiveKey(parent, k) {
+				m[k] = redacted
+				continue
+			}
+			m[k] = redactJSON(k, value)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, value := range v {
+			s[i] = redactJSON(parent, value)
+		}
+		return s
+	}
+	return v
+}
+
+// nonSensitiveKeys are the keys ending in "_key" whose values are not
+// credentials.
+var nonSensitiveKeys = map[string]bool{
+	"public_key": true,
+	"sort_key":   true,
+}
+
+// isSensitiveKey reports whether the value of key, found in an object which is
+// the value of parent, must be redacted.
+func isSensitiveKey(parent, key string) bool {
+	k := strings.ToLower(key)
+	if strings.Contains(k, "password") || strings.Contains(k, "secret") || strings.HasSuffix(k, "pass") {
+		return true
+	}
+	// Private keys, such as the one of a keypair created by the compute
+	// service, and other keys used as credentials.
+	if (strings.HasSuffix(k, "_key") && !nonSensitiveKeys[k]) || k == "apikey" {
+		return true
+	}
+	// The token issued by the identity v2 service, and the token used to
+	// authenticate against the identity v3 service.
+	return k == "id" && strings.ToLower(parent) == "token"
+}
@@ -86,6 +86,43 @@ intermediary processing on each page, you can use the AllPages method:
 	allPages, err := servers.List(client, nil).AllPages()
 	allServers, err := servers.ExtractServers(allPages)
 
+Contexts
+
+Every request can be bound to a context.Context, which allows in-flight calls
+to be cancelled and per-call deadlines to be set. Use WithContext to obtain a
+copy of a service client bound to a context; it can be passed to any resource
+package in place of the original client:
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server, err := servers.Get(client.WithContext(ctx), "{serverId}").Extract()
+
+Pagers additionally offer EachPageWithContext and AllPagesWithContext, and a
+context can be set on a single request through RequestOpts.Context or on every
+request issued by a provider through ProviderClient.Context.
+
+Token caching
+
+Short-lived programs can avoid authenticating on every run by setting a
+TokenCache on the provider before authenticating. A cached token is reused
+after the identity service confirms it is still valid, and tokens are renewed
+shortly before they expire:
+
+	provider, err := openstack.NewClient(opts.IdentityEndpoint)
+	provider.TokenCache = gophercloud.NewFileTokenCache("/home/me/.cache/gophercloud")
+	err = openstack.Authenticate(provider, opts)
+
+Logging and tracing
+
+Every HTTP request issued by a provider, along with the type and microversion
+of the service client which issued it, can be observed by adding a RequestHook
+to ProviderClient.RequestHooks. DebugLogger is a hook which logs requests and
+responses with their tokens, passwords and secrets redacted:
+
+	provider.RequestHooks = append(provider.RequestHooks,
+		gophercloud.NewDebugLogger(log.New(os.Stderr, "", log.LstdFlags)))
+
 This top-level package contains utility functions and data types that are used
 throughout the provider and service packages. Of particular note for end users
 are the AuthOptions and EndpointOpts structs.
@@ -2,6 +2,7 @@ package gophercloud
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 )
 
@@ -82,6 +83,18 @@ type ErrUnexpectedResponseCode struct {
 	Expected []int
 	Actual   int
 	Body     []byte
+
+	// ResponseHeader holds the headers of the response.
+	ResponseHeader http.Header
+
+	// RequestID is the ID the service assigned to the request, taken from the
+	// X-Openstack-Request-Id header or one of its service specific variants.
+	// Quote it when reporting a problem to the cloud operator.
+	RequestID string
+
+	// Fault is the error reported in the body of the response, or nil if the
+	// body could not be decoded.
+	Fault *Fault
 }
 
 func (e ErrUnexpectedResponseCode) Error() string {
@@ -89,9 +102,44 @@ func (e ErrUnexpectedResponseCode) Error() string {
 		"Expected HTTP response code %v when accessing [%s %s], but got %d instead\n%s",
 		e.Expected, e.Method, e.URL, e.Actual, e.Body,
 	)
+	if e.RequestID != "" {
+		e.DefaultErrString += fmt.Sprintf("\nRequest ID: %s", e.RequestID)
+	}
 	return e.choseErrString()
 }
 
+// GetStatusCode returns the actual status code of the response.
+func (e ErrUnexpectedResponseCode) GetStatusCode() int {
+	return e.Actual
+}
+
+// StatusCodeError is the interface implemented by errors which carry the
+// status code of an HTTP response.
+type StatusCodeError interface {
+	Error() string
+	GetStatusCode() int
+}
+
+// ResponseCodeIs reports whether err, or an error it wraps, is a
+// StatusCodeError with the given status code.
+//
+//	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+//		// The resource is already gone.
+//	}
+func ResponseCodeIs(err error, status int) bool {
+	for err != nil {
+		if e, ok := err.(StatusCodeError); ok && e.GetStatusCode() == status {
+			return true
+		}
+		u, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			return false
+		}
+		err = u.Unwrap()
+	}
+	return false
+}
+
 // ErrDefault400 is the default error type returned on a 400 HTTP response code.
 type ErrDefault400 struct {
 	ErrUnexpectedResponseCode
@@ -175,6 +223,31 @@ func (e ErrDefault503) Error() string {
 		" overloading or maintenance. This is a temporary condition. Try again later."
 }
 
+// The default errors unwrap to their ErrUnexpectedResponseCode, so that
+// errors.As can extract it, and match any error of the same type in errors.Is:
+//
+//	if errors.Is(err, gophercloud.ErrDefault404{}) {
+//		// The resource is already gone.
+//	}
+func (e ErrDefault400) Unwrap() error        { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault400) Is(target error) bool { _, ok := target.(ErrDefault400); return ok }
+func (e ErrDefault401) Unwrap() error        { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault401) Is(target error) bool { _, ok := target.(ErrDefault401); return ok }
+func (e ErrDefault403) Unwrap() error        { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault403) Is(target error) bool { _, ok := target.(ErrDefault403); return ok }
+func (e ErrDefault404) Unwrap() error        { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault404) Is(target error) bool { _, ok := target.(ErrDefault404); return ok }
+func (e ErrDefault405) Unwrap() error        { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault405) Is(target error) bool { _, ok := target.(ErrDefault405); return ok }
+func (e ErrDefault408) Unwrap() error        { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault408) Is(target error) bool { _, ok := target.(ErrDefault408); return ok }
+func (e ErrDefault429) Unwrap() error        { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault429) Is(target error) bool { _, ok := target.(ErrDefault429); return ok }
+func (e ErrDefault500) Unwrap() error        { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault500) Is(target error) bool { _, ok := target.(ErrDefault500); return ok }
+func (e ErrDefault503) Unwrap() error        { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault503) Is(target error) bool { _, ok := target.(ErrDefault503); return ok }
+
 // Err400er is the interface resource error types implement to override the error message
 // from a 400 error.
 type Err400er interface {
@@ -239,6 +312,30 @@ func (e ErrTimeOut) Error() string {
 	return e.choseErrString()
 }
 
+// ErrWaitFailure is returned by Waiter.Wait when the resource reaches a
+// failure state, or is deleted while waiting for another state.
+type ErrWaitFailure struct {
+	BaseError
+	State string
+
+	// Resource is the resource as last fetched, or nil if it was deleted.
+	Resource interface{}
+
+	// Err is the error the resource was refreshed with, such as the
+	// ErrDefault404 of a resource which was deleted, or nil.
+	Err error
+}
+
+func (e ErrWaitFailure) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Resource reached unexpected state %s", e.State)
+	return e.choseErrString()
+}
+
+// Unwrap returns the error the resource was refreshed with.
+func (e ErrWaitFailure) Unwrap() error {
+	return e.Err
+}
+
 // ErrUnableToReauthenticate is the error type returned when reauthentication fails.
 type ErrUnableToReauthenticate struct {
 	BaseError
@@ -250,6 +347,11 @@ func (e ErrUnableToReauthenticate) Error() string {
 	return e.choseErrString()
 }
 
+// Unwrap returns the error which caused the reauthentication.
+func (e ErrUnableToReauthenticate) Unwrap() error {
+	return e.ErrOriginal
+}
+
 // ErrErrorAfterReauthentication is the error type returned when reauthentication
 // succeeds, but an error occurs afterword (usually an HTTP error).
 type ErrErrorAfterReauthentication struct {
@@ -262,6 +364,11 @@ func (e ErrErrorAfterReauthentication) Error() string {
 	return e.choseErrString()
 }
 
+// Unwrap returns the error the request failed with after reauthenticating.
+func (e ErrErrorAfterReauthentication) Unwrap() error {
+	return e.ErrOriginal
+}
+
 // ErrServiceNotFound is returned when no service in a service catalog matches
 // the provided EndpointOpts. This is generally returned by provider service
 // factory methods like "NewComputeV2()" and can mean that a service is not
@@ -451,3 +558,10 @@ type ErrScopeEmpty struct{ BaseError }
 func (e ErrScopeEmpty) Error() string {
 	return "You must provide either a Project or Domain in a Scope"
 }
+
+// ErrAppCredMissingSecret indicates that no Application Credential Secret was provided with Application Credential ID or Name
+type ErrAppCredMissingSecret struct{ BaseError }
+
+func (e ErrAppCredMissingSecret) Error() string {
+	return "You must provide an Application Credential Secret"
+}
@@ -0,0 +1,122 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Fault is an error reported by an OpenStack service in the body of a
+// response. Each service has its own format; Fault holds the details common
+// to all of them.
+type Fault struct {
+	// Type is the kind of the fault, such as "itemNotFound" for the compute
+	// and block storage services, or "NetworkNotFound" for the networking
+	// service.
+	Type string
+
+	// Code is the status code reported in the body, if any.
+	Code int
+
+	// Message is the human readable description of the fault.
+	Message string
+
+	// Details holds additional information about the fault, if any.
+	Details string
+}
+
+// parseFault decodes the fault reported in the body of an error response. It
+// returns nil if the body does not hold a fault in a known format.
+func parseFault(body []byte) *Fault {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(body, &m); err != nil || len(m) == 0 {
+		return nil
+	}
+
+	var s struct {
+		Type        string          `json:"type"`
+		Title       string          `json:"title"`
+		Code        json.RawMessage `json:"code"`
+		Message     string          `json:"message"`
+		Detail      string          `json:"detail"`
+		Details     string          `json:"details"`
+		FaultString string          `json:"faultstring"`
+		FaultCode   string          `json:"faultcode"`
+	}
+
+	switch {
+	case m["NeutronError"] != nil:
+		// {"NeutronError": {"type": "...", "message": "...", "detail": ""}}
+		if json.Unmarshal(m["NeutronError"], &s) != nil {
+			return nil
+		}
+	case m["error"] != nil:
+		// Identity: {"error": {"code": 404, "title": "Not Found", "message": "..."}}
+		// Orchestration: {"error": {"type": "...", "message": "..."}, "code": 404, "title": "..."}
+		if json.Unmarshal(m["error"], &s) != nil {
+			// Some services report the message as a plain string.
+			var msg string
+			if json.Unmarshal(m["error"], &msg) != nil {
+				return nil
+			}
+			s.Message = msg
+		}
+		if s.Code == nil {
+			s.Code = m["code"]
+		}
+		if s.Title == "" && m["title"] != nil {
+			json.Unmarshal(m["title"], &s.Title)
+		}
+	case m["errors"] != nil:
+		// Placement and others: {"errors": [{"status": 404, "title": "...", "detail": "..."}]}
+		var errs []struct {
+			Status int    `json:"status"`
+			Title  string `json:"title"`
+			Detail string `json:"detail"`
+		}
+		if json.Unmarshal(m["errors"], &errs) != nil || len(errs) == 0 {
+			return nil
+		}
+		return &Fault{Type: errs[0].Title, Code: errs[0].Status, Message: errs[0].Detail}
+	case m["faultstring"] != nil:
+		// Load balancing and bare metal: {"faultcode": "Client", "faultstring": "..."}
+		if json.Unmarshal(body, &s) != nil {
+			return nil
+		}
+		return &Fault{Type: s.FaultCode, Message: s.FaultString}
+	case m["message"] != nil:
+		// {"code": 404, "type": "...", "message": "..."}
+		if json.Unmarshal(body, &s) != nil {
+			return nil
+		}
+	case len(m) == 1:
+		// Compute, block storage and shared file systems:
+		// {"itemNotFound": {"code": 404, "message": "..."}}
+		for k, v := range m {
+			if json.Unmarshal(v, &s) != nil || s.Message == "" {
+				return nil
+			}
+			s.Type = k
+		}
+	default:
+		return nil
+	}
+
+	f := &Fault{
+		Type:    s.Type,
+		Message: s.Message,
+		Details: s.Details,
+	}
+	if f.Type == "" {
+		f.Type = s.Title
+	}
+	if f.Details == "" {
+		f.Details = s.Detail
+	}
+	if code := strings.Trim(string(s.Code), `"`); code != "" {
+		json.Unmarshal([]byte(code), &f.Code)
+	}
+	if f.Message == "" && f.Type == "" {
+		return nil
+	}
+	return f
+}
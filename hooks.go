@@ -0,0 +1,95 @@
+package gophercloud
+
+import (
+	"net/http"
+	"time"
+)
+
+// RequestHook observes the HTTP requests issued by a ProviderClient. Add
+// hooks to ProviderClient.RequestHooks to log or trace the traffic of every
+// service client that shares the provider. See DebugLogger.
+type RequestHook interface {
+	// BeforeRequest is called before every attempt to send a request,
+	// including retries. It may add headers to info.Request, for instance
+	// to propagate a trace.
+	BeforeRequest(info *RequestInfo)
+
+	// AfterResponse is called once the response headers of an attempt were
+	// received, or the attempt failed with a transport error. A hook which
+	// reads info.Response.Body must replace it with an equivalent reader.
+	AfterResponse(info *RequestInfo)
+}
+
+// RequestInfo describes a request issued by a ProviderClient, as seen by a
+// RequestHook.
+type RequestInfo struct {
+	// ServiceType is the type of the service client which issued the
+	// request, such as "compute". It is empty for requests issued directly
+	// through a ProviderClient.
+	ServiceType string
+
+	// Microversion is the microversion requested from the service, if any.
+	Microversion string
+
+	// Attempt is the number of the attempt, starting from 1. It increases
+	// when the request is retried according to ProviderClient.RetryPolicy.
+	Attempt int
+
+	// Request is the HTTP request being sent.
+	Request *http.Request
+
+	// Response is the HTTP response. It is nil before the request is sent,
+	// and if the request failed with a transport error.
+	Response *http.Response
+
+	// Err is the transport error the request failed with, if any.
+	Err error
+
+	// Latency is the time elapsed between sending the request and receiving
+	// the response headers.
+	Latency time.Duration
+}
+
+// RequestID returns the ID the service assigned to the request, read from
+// the X-Openstack-Request-Id header of the response, or from one of its
+// service specific variants. It is empty if there is no response.
+func (info *RequestInfo) RequestID() string {
+	if info.Response == nil {
+		return ""
+	}
+	return requestID(info.Response.Header)
+}
+
+// requestID returns the request ID found in the headers of a response.
+func requestID(h http.Header) string {
+	for _, k := range []string{"X-Openstack-Request-Id", "X-Compute-Request-Id", "X-Trans-Id"} {
+		if v := h.Get(k); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// do sends req through the HTTP client, notifying the request hooks.
+func (client *ProviderClient) do(req *http.Request, info RequestInfo) (*http.Response, error) {
+	if len(client.RequestHooks) == 0 {
+		return client.HTTPClient.Do(req)
+	}
+
+	info.Request = req
+	for _, hook := range client.RequestHooks {
+		hook.BeforeRequest(&info)
+	}
+
+	start := time.Now()
+	resp, err := client.HTTPClient.Do(info.Request)
+	info.Latency = time.Since(start)
+	info.Response = resp
+	info.Err = err
+
+	for _, hook := range client.RequestHooks {
+		hook.AfterResponse(&info)
+	}
+
+	return resp, err
+}
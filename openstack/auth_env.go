@@ -23,6 +23,11 @@ OS_TENANT_ID and OS_TENANT_NAME are mutually exclusive to OS_PROJECT_ID and
 OS_PROJECT_NAME. If OS_PROJECT_ID and OS_PROJECT_NAME are set, they will
 still be referred as "tenant" in Gophercloud.
 
+Application credentials may be used instead of a password by setting
+OS_APPLICATION_CREDENTIAL_SECRET along with either
+OS_APPLICATION_CREDENTIAL_ID, or OS_APPLICATION_CREDENTIAL_NAME and one of
+OS_USERNAME or OS_USERID. In that case OS_PASSWORD is not required.
+
 To use this function, first set the OS_* environment variables (for example,
 by sourcing an `openrc` file), then:
 
@@ -38,6 +43,9 @@ func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 	tenantName := os.Getenv("OS_TENANT_NAME")
 	domainID := os.Getenv("OS_DOMAIN_ID")
 	domainName := os.Getenv("OS_DOMAIN_NAME")
+	applicationCredentialID := os.Getenv("OS_APPLICATION_CREDENTIAL_ID")
+	applicationCredentialName := os.Getenv("OS_APPLICATION_CREDENTIAL_NAME")
+	applicationCredentialSecret := os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET")
 
 	// If OS_PROJECT_ID is set, overwrite tenantID with the value.
 	if v := os.Getenv("OS_PROJECT_ID"); v != "" {
@@ -56,20 +64,28 @@ func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 		return nilOptions, err
 	}
 
-	if username == "" && userID == "" {
+	// An application credential ID identifies its user on its own.
+	if username == "" && userID == "" && applicationCredentialID == "" {
 		err := gophercloud.ErrMissingAnyoneOfEnvironmentVariables{
 			EnvironmentVariables: []string{"OS_USERNAME", "OS_USERID"},
 		}
 		return nilOptions, err
 	}
 
-	if password == "" {
+	if password == "" && applicationCredentialID == "" && applicationCredentialName == "" {
 		err := gophercloud.ErrMissingEnvironmentVariable{
 			EnvironmentVariable: "OS_PASSWORD",
 		}
 		return nilOptions, err
 	}
 
+	if (applicationCredentialID != "" || applicationCredentialName != "") && applicationCredentialSecret == "" {
+		err := gophercloud.ErrMissingEnvironmentVariable{
+			EnvironmentVariable: "OS_APPLICATION_CREDENTIAL_SECRET",
+		}
+		return nilOptions, err
+	}
+
 	ao := gophercloud.AuthOptions{
 		IdentityEndpoint: authURL,
 		UserID:           userID,
@@ -79,6 +95,10 @@ func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 		TenantName:       tenantName,
 		DomainID:         domainID,
 		DomainName:       domainName,
+
+		ApplicationCredentialID:     applicationCredentialID,
+		ApplicationCredentialName:   applicationCredentialName,
+		ApplicationCredentialSecret: applicationCredentialSecret,
 	}
 
 	return ao, nil
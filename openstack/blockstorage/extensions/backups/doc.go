@@ -0,0 +1,80 @@
+/*
+Package backups provides information and interaction with backups in the
+OpenStack Block Storage service. A backup is a full copy of a volume, stored
+in an external service such as Object Storage, from which the volume can be
+restored.
+
+Example to List Backups
+
+	listOpts := backups.ListOpts{
+		VolumeID: "uuid",
+	}
+
+	allPages, err := backups.ListDetail(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allBackups, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, backup := range allBackups {
+		fmt.Println(backup)
+	}
+
+Example to Create a Backup and Wait for it to be Available
+
+	createOpts := backups.CreateOpts{
+		VolumeID:    "uuid",
+		Name:        "my-backup",
+		Incremental: true,
+	}
+
+	backup, err := backups.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	waiter := backups.NewWaiter(client, backup.ID, "available")
+	waiter.Timeout = 30 * time.Minute
+	_, err = waiter.Wait(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Backup
+
+	backupID := "2a6be9ae-8d58-4d46-a7b5-b5d5a9e3c9a3"
+	err := backups.Delete(client, backupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Restore a Backup into a new Volume
+
+	restoreOpts := backups.RestoreOpts{
+		Name: "restored-volume",
+	}
+
+	restore, err := backups.RestoreFromBackup(client, backupID, restoreOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Restoring into volume %s\n", restore.VolumeID)
+
+Example to Export and Import a Backup
+
+	record, err := backups.Export(client, backupID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	backup, err := backups.Import(otherClient, backups.ImportOpts(*record)).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package backups
@@ -0,0 +1,232 @@
+package backups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToBackupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for creating a Backup. This object is passed to
+// the backups.Create function. For more information about these parameters,
+// see the Backup object.
+type CreateOpts struct {
+	// VolumeID is the ID of the volume to create the backup from.
+	VolumeID string `json:"volume_id" required:"true"`
+
+	// Force will force the creation of a backup regardless of the
+	// volume's status.
+	Force bool `json:"force,omitempty"`
+
+	// Name is the name of the backup.
+	Name string `json:"name,omitempty"`
+
+	// Description is the description of the backup.
+	Description string `json:"description,omitempty"`
+
+	// Metadata is metadata for the backup.
+	// Requires microversion 3.43 or later.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Container is a container to store the backup.
+	Container string `json:"container,omitempty"`
+
+	// Incremental is whether the backup should be incremental or not.
+	Incremental bool `json:"incremental,omitempty"`
+
+	// SnapshotID is the ID of a snapshot to backup.
+	SnapshotID string `json:"snapshot_id,omitempty"`
+
+	// AvailabilityZone is an availability zone locate the volume or snapshot.
+	// Requires microversion 3.51 or later.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToBackupCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToBackupCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "backup")
+}
+
+// Create will create a new Backup based on the values in CreateOpts. To
+// extract the Backup object from the response, call the Extract method on the
+// CreateResult.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBackupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Delete will delete the existing Backup with the provided ID.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
+
+// Get retrieves the Backup with the provided ID. To extract the Backup
+// object from the response, call the Extract method on the GetResult.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// and ListDetail requests.
+type ListOptsBuilder interface {
+	ToBackupListQuery() (string, error)
+}
+
+// ListOpts contains options for filtering the backups listed by List and
+// ListDetail.
+type ListOpts struct {
+	// AllTenants will retrieve backups of all tenants/projects.
+	AllTenants bool `q:"all_tenants"`
+
+	// Name will filter by the specified backup name.
+	Name string `q:"name"`
+
+	// Status will filter by the specified status.
+	Status string `q:"status"`
+
+	// TenantID will filter by a specific tenant/project ID.
+	// Setting AllTenants is required to use this.
+	TenantID string `q:"project_id"`
+
+	// VolumeID will filter by a specified volume ID.
+	VolumeID string `q:"volume_id"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToBackupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToBackupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Backups optionally limited by the conditions provided in
+// ListOpts. Only the ID and the Name of the Backups are returned; use
+// ListDetail to get all their attributes.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns Backups with all their attributes, optionally limited
+// by the conditions provided in ListOpts.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToBackupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// RestoreOptsBuilder allows extensions to add additional parameters to the
+// Restore request.
+type RestoreOptsBuilder interface {
+	ToRestoreMap() (map[string]interface{}, error)
+}
+
+// RestoreOpts contains options for restoring a Backup. This object is passed
+// to the backups.RestoreFromBackup function.
+type RestoreOpts struct {
+	// VolumeID is the ID of the existing volume to restore the backup into.
+	// A new volume is created if it is empty.
+	VolumeID string `json:"volume_id,omitempty"`
+
+	// Name is the name of the new volume to restore the backup into. It is
+	// ignored when VolumeID is set.
+	Name string `json:"name,omitempty"`
+}
+
+// ToRestoreMap assembles a request body based on the contents of a
+// RestoreOpts.
+func (opts RestoreOpts) ToRestoreMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "restore")
+}
+
+// RestoreFromBackup will restore a Backup to a volume based on the values in
+// RestoreOpts. To extract the Restore object from the response, call the
+// Extract method on the RestoreResult.
+func RestoreFromBackup(client *gophercloud.ServiceClient, id string, opts RestoreOptsBuilder) (r RestoreResult) {
+	b, err := opts.ToRestoreMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(restoreURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Export will export a Backup information. To extract the Backup export
+// record object from the response, call the Extract method on the
+// ExportResult.
+func Export(client *gophercloud.ServiceClient, id string) (r ExportResult) {
+	_, r.Err = client.Get(exportURL(client, id), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ImportOptsBuilder allows extensions to add additional parameters to the
+// Import request.
+type ImportOptsBuilder interface {
+	ToBackupImportMap() (map[string]interface{}, error)
+}
+
+// ImportOpts contains options for importing a Backup. This object is passed
+// to the backups.Import function.
+type ImportOpts BackupRecord
+
+// ToBackupImportMap assembles a request body based on the contents of an
+// ImportOpts.
+func (opts ImportOpts) ToBackupImportMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "backup-record")
+}
+
+// Import will import a Backup data to a backup based on the values in
+// ImportOpts. To extract the Backup object from the response, call the
+// Extract method on the ImportResult.
+func Import(client *gophercloud.ServiceClient, opts ImportOptsBuilder) (r ImportResult) {
+	b, err := opts.ToBackupImportMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(importURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
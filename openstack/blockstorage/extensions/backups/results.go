@@ -0,0 +1,227 @@
+package backups
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Backup contains all the information associated with a Cinder Backup.
+type Backup struct {
+	// ID is the Unique identifier of the backup.
+	ID string `json:"id"`
+
+	// CreatedAt is the date the backup was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the backup was updated.
+	UpdatedAt time.Time `json:"-"`
+
+	// DataTimestamp is the time when the data on the volume was first saved.
+	// For a backup created from a snapshot, it is the time the snapshot was
+	// taken.
+	DataTimestamp time.Time `json:"-"`
+
+	// Name is the display name of the backup.
+	Name string `json:"name"`
+
+	// Description is the description of the backup.
+	Description string `json:"description"`
+
+	// VolumeID is the ID of the Volume from which this backup was created.
+	VolumeID string `json:"volume_id"`
+
+	// SnapshotID is the ID of the snapshot from which this backup was
+	// created, if any.
+	SnapshotID string `json:"snapshot_id"`
+
+	// Status is the status of the backup.
+	Status string `json:"status"`
+
+	// Size is the size of the backup, in GB.
+	Size int `json:"size"`
+
+	// ObjectCount is the number of objects in the backup.
+	ObjectCount int `json:"object_count"`
+
+	// Container is the container where the backup is stored.
+	Container string `json:"container"`
+
+	// AvailabilityZone is the availability zone of the backup.
+	AvailabilityZone string `json:"availability_zone"`
+
+	// HasDependentBackups is whether there are other backups
+	// depending on this backup.
+	HasDependentBackups bool `json:"has_dependent_backups"`
+
+	// FailReason has the reason for the backup failure.
+	FailReason string `json:"fail_reason"`
+
+	// IsIncremental is whether this is an incremental backup.
+	IsIncremental bool `json:"is_incremental"`
+
+	// Metadata is metadata about the backup.
+	// This requires microversion 3.43 or later.
+	Metadata map[string]string `json:"metadata"`
+
+	// ProjectID is the ID of the project that owns the backup. This is
+	// an admin-only field. This requires microversion 3.18 or later.
+	ProjectID string `json:"os-backup-project-attr:project_id"`
+
+	// UserID is the ID of the user that owns the backup.
+	// This requires microversion 3.56 or later.
+	UserID string `json:"user_id"`
+
+	// EncryptionKeyID is the ID of the encryption key used to encrypt the
+	// backup. This requires microversion 3.64 or later.
+	EncryptionKeyID string `json:"encryption_key_id"`
+
+	// Links includes links associated with the backup.
+	Links []gophercloud.Link `json:"links"`
+}
+
+// UnmarshalJSON converts our JSON API response into our backup struct
+func (r *Backup) UnmarshalJSON(b []byte) error {
+	type tmp Backup
+	var s struct {
+		tmp
+		CreatedAt     gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt     gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+		DataTimestamp gophercloud.JSONRFC3339MilliNoZ `json:"data_timestamp"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Backup(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+	r.DataTimestamp = time.Time(s.DataTimestamp)
+
+	return err
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// BackupPage is a pagination.Pager that is returned from a call to the List
+// and ListDetail functions.
+type BackupPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a BackupPage contains no Backups.
+func (r BackupPage) IsEmpty() (bool, error) {
+	backups, err := ExtractBackups(r)
+	return len(backups) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r BackupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"backups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractBackups extracts and returns Backups. It is used while iterating
+// over a backups.List or backups.ListDetail call.
+func ExtractBackups(r pagination.Page) ([]Backup, error) {
+	var s struct {
+		Backups []Backup `json:"backups"`
+	}
+	err := (r.(BackupPage)).ExtractInto(&s)
+	return s.Backups, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Backup object out of the commonResult object.
+func (r commonResult) Extract() (*Backup, error) {
+	var s struct {
+		Backup *Backup `json:"backup"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Backup, err
+}
+
+// Restore contains all the information associated with a Cinder Backup
+// restore response.
+type Restore struct {
+	// BackupID is the Unique identifier of the backup.
+	BackupID string `json:"backup_id"`
+
+	// VolumeID is the Unique identifier of the volume the backup is
+	// restored into.
+	VolumeID string `json:"volume_id"`
+
+	// VolumeName is the name of the volume the backup is restored into.
+	VolumeName string `json:"volume_name"`
+}
+
+// RestoreResult contains the response body and error from a restore request.
+type RestoreResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Restore object out of the RestoreResult object.
+func (r RestoreResult) Extract() (*Restore, error) {
+	var s struct {
+		Restore *Restore `json:"restore"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Restore, err
+}
+
+// BackupRecord contains an information about a backup backend storage, used
+// to import the backup into another Block Storage service.
+type BackupRecord struct {
+	// BackupService is the name of the backup backend storage service, such
+	// as "cinder.backup.drivers.swift.SwiftBackupDriver".
+	BackupService string `json:"backup_service"`
+
+	// BackupURL is the encoded backup metadata. It is base64-encoded in the
+	// requests and responses.
+	BackupURL []byte `json:"backup_url"`
+}
+
+// ExportResult contains the response body and error from an export request.
+type ExportResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the BackupRecord object out of the ExportResult object.
+func (r ExportResult) Extract() (*BackupRecord, error) {
+	var s struct {
+		BackupRecord *BackupRecord `json:"backup-record"`
+	}
+	err := r.ExtractInto(&s)
+	return s.BackupRecord, err
+}
+
+// ImportResult contains the response body and error from an import request.
+type ImportResult struct {
+	commonResult
+}
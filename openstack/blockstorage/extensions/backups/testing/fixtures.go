@@ -0,0 +1,286 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const ListResponse = `
+{
+  "backups": [
+    {
+      "id": "289da7f8-6440-407c-9fb4-7db01ec49164",
+      "name": "backup-001"
+    },
+    {
+      "id": "96c3bda7-c82a-4f50-be73-ca7621794835",
+      "name": "backup-002"
+    }
+  ],
+  "backups_links": [
+    {
+      "href": "%s/backups?marker=1",
+      "rel": "next"
+    }
+  ]
+}
+`
+
+const ListDetailResponse = `
+{
+  "backups": [
+    {
+      "id": "289da7f8-6440-407c-9fb4-7db01ec49164",
+      "name": "backup-001",
+      "volume_id": "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+      "description": "Daily Backup",
+      "status": "available",
+      "size": 30,
+      "created_at": "2017-05-30T03:35:03.000000"
+    },
+    {
+      "id": "96c3bda7-c82a-4f50-be73-ca7621794835",
+      "name": "backup-002",
+      "volume_id": "76b8950a-8594-4e5b-8dce-0dfa9c696358",
+      "description": "Weekly Backup",
+      "status": "available",
+      "size": 25,
+      "created_at": "2017-05-30T03:35:03.000000"
+    }
+  ],
+  "backups_links": [
+    {
+      "href": "%s/backups/detail?marker=1",
+      "rel": "next"
+    }
+  ]
+}
+`
+
+const GetResponse = `
+{
+  "backup": {
+    "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "name": "backup-001",
+    "description": "Daily backup",
+    "volume_id": "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+    "snapshot_id": "",
+    "status": "available",
+    "size": 30,
+    "object_count": 2,
+    "container": "my-test-backup",
+    "availability_zone": "nova",
+    "has_dependent_backups": false,
+    "fail_reason": null,
+    "is_incremental": true,
+    "created_at": "2017-05-30T03:35:03.000000",
+    "updated_at": "2017-05-30T03:36:03.000000",
+    "data_timestamp": "2017-05-30T03:35:00.000000",
+    "metadata": {
+      "key": "value"
+    },
+    "os-backup-project-attr:project_id": "2c67a14be9314c5dae2ee6c4ec90cf0b"
+  }
+}
+`
+
+const CreateRequest = `
+{
+  "backup": {
+    "volume_id": "1234",
+    "name": "backup-001",
+    "incremental": true,
+    "force": true
+  }
+}
+`
+
+const CreateResponse = `
+{
+  "backup": {
+    "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "links": [],
+    "name": "backup-001"
+  }
+}
+`
+
+const RestoreRequest = `
+{
+  "restore": {
+    "name": "restored-volume"
+  }
+}
+`
+
+const RestoreResponse = `
+{
+  "restore": {
+    "backup_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "volume_id": "795114e8-7489-40be-a978-83797f2c1dd3",
+    "volume_name": "restored-volume"
+  }
+}
+`
+
+const ExportResponse = `
+{
+  "backup-record": {
+    "backup_service": "cinder.backup.drivers.swift.SwiftBackupDriver",
+    "backup_url": "eyJpZCI6ICJkMzIwMTlkMy1iYzZlLTQzMTktOWMxZC02NzIyZmMxMzZhMjIifQ=="
+  }
+}
+`
+
+const ImportRequest = ExportResponse
+
+const ImportResponse = `
+{
+  "backup": {
+    "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "links": [],
+    "name": null
+  }
+}
+`
+
+var ExpectedBackup = backups.Backup{
+	ID:                  "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+	Name:                "backup-001",
+	Description:         "Daily backup",
+	VolumeID:            "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+	Status:              "available",
+	Size:                30,
+	ObjectCount:         2,
+	Container:           "my-test-backup",
+	AvailabilityZone:    "nova",
+	HasDependentBackups: false,
+	IsIncremental:       true,
+	CreatedAt:           time.Date(2017, 5, 30, 3, 35, 3, 0, time.UTC),
+	UpdatedAt:           time.Date(2017, 5, 30, 3, 36, 3, 0, time.UTC),
+	DataTimestamp:       time.Date(2017, 5, 30, 3, 35, 0, 0, time.UTC),
+	Metadata:            map[string]string{"key": "value"},
+	ProjectID:           "2c67a14be9314c5dae2ee6c4ec90cf0b",
+}
+
+var ExpectedBackupRecord = backups.BackupRecord{
+	BackupService: "cinder.backup.drivers.swift.SwiftBackupDriver",
+	BackupURL:     []byte(`{"id": "d32019d3-bc6e-4319-9c1d-6722fc136a22"}`),
+}
+
+func MockListResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, ListResponse, th.Server.URL)
+		case "1":
+			fmt.Fprintf(w, `{"backups": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func MockListDetailResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			th.CheckEquals(t, "available", r.Form.Get("status"))
+			fmt.Fprintf(w, ListDetailResponse, th.Server.URL)
+		case "1":
+			fmt.Fprintf(w, `{"backups": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func MockGetResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups/d32019d3-bc6e-4319-9c1d-6722fc136a22", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetResponse)
+	})
+}
+
+func MockCreateResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, CreateResponse)
+	})
+}
+
+func MockDeleteResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups/d32019d3-bc6e-4319-9c1d-6722fc136a22", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func MockRestoreResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups/d32019d3-bc6e-4319-9c1d-6722fc136a22/restore", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, RestoreRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, RestoreResponse)
+	})
+}
+
+func MockExportResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups/d32019d3-bc6e-4319-9c1d-6722fc136a22/export_record", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ExportResponse)
+	})
+}
+
+func MockImportResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups/import_record", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, ImportRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, ImportResponse)
+	})
+}
@@ -0,0 +1,152 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockListResponse(t)
+
+	count := 0
+
+	backups.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := backups.ExtractBackups(page)
+		if err != nil {
+			t.Errorf("Failed to extract backups: %v", err)
+			return false, err
+		}
+
+		expected := []backups.Backup{
+			{
+				ID:   "289da7f8-6440-407c-9fb4-7db01ec49164",
+				Name: "backup-001",
+			},
+			{
+				ID:   "96c3bda7-c82a-4f50-be73-ca7621794835",
+				Name: "backup-002",
+			},
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestListDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockListDetailResponse(t)
+
+	allPages, err := backups.ListDetail(client.ServiceClient(), backups.ListOpts{Status: "available"}).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := backups.ExtractBackups(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(actual))
+	th.AssertEquals(t, "Daily Backup", actual[0].Description)
+	th.AssertEquals(t, 25, actual[1].Size)
+	th.AssertEquals(t, "76b8950a-8594-4e5b-8dce-0dfa9c696358", actual[1].VolumeID)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetResponse(t)
+
+	v, err := backups.Get(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22").Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, ExpectedBackup, *v)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockCreateResponse(t)
+
+	options := backups.CreateOpts{VolumeID: "1234", Name: "backup-001", Incremental: true, Force: true}
+	n, err := backups.Create(client.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, n.ID, "d32019d3-bc6e-4319-9c1d-6722fc136a22")
+	th.AssertEquals(t, n.Name, "backup-001")
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockDeleteResponse(t)
+
+	res := backups.Delete(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestRestore(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockRestoreResponse(t)
+
+	options := backups.RestoreOpts{Name: "restored-volume"}
+	n, err := backups.RestoreFromBackup(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, n.BackupID, "d32019d3-bc6e-4319-9c1d-6722fc136a22")
+	th.AssertEquals(t, n.VolumeID, "795114e8-7489-40be-a978-83797f2c1dd3")
+	th.AssertEquals(t, n.VolumeName, "restored-volume")
+}
+
+func TestExport(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockExportResponse(t)
+
+	n, err := backups.Export(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22").Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, ExpectedBackupRecord, *n)
+}
+
+func TestImport(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockImportResponse(t)
+
+	n, err := backups.Import(client.ServiceClient(), backups.ImportOpts(ExpectedBackupRecord)).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, n.ID, "d32019d3-bc6e-4319-9c1d-6722fc136a22")
+}
+
+func TestWaiter(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetResponse(t)
+
+	v, err := backups.NewWaiter(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", "available").Wait(context.Background())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "available", v.(*backups.Backup).Status)
+}
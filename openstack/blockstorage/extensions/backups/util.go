@@ -0,0 +1,25 @@
+package backups
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// NewWaiter returns a Waiter which polls a backup until its status is one of
+// target, such as "available" once it is created or restored. The error
+// statuses of a backup are failures. The Wait method of the Waiter returns
+// the backup as a *Backup.
+func NewWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.Waiter {
+	return &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			v, err := Get(c.WithContext(ctx), id).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return v, v.Status, nil
+		},
+		Target:  target,
+		Failure: []string{"error", "error_deleting", "error_restoring"},
+	}
+}
@@ -3,6 +3,10 @@ Package volumeactions provides information and interaction with volumes in the
 OpenStack Block Storage service. A volume is a detachable block storage
 device, akin to a USB hard drive.
 
+On Block Storage v3 with microversion 3.27 or later, the attachments package
+should be used instead of the Reserve, InitializeConnection and Attach
+actions.
+
 Example of Attaching a Volume to an Instance
 
 	attachOpts := volumeactions.AttachOpts{
@@ -0,0 +1,106 @@
+package volumetransfers
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Transfer represents a Volume Transfer record
+type Transfer struct {
+	ID string `json:"id"`
+
+	// AuthKey is the key the project accepting the transfer must provide.
+	// It is only returned by Create.
+	AuthKey string `json:"auth_key"`
+
+	Name     string             `json:"name"`
+	VolumeID string             `json:"volume_id"`
+	Links    []gophercloud.Link `json:"links"`
+
+	CreatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON is our unmarshalling helper
+func (r *Transfer) UnmarshalJSON(b []byte) error {
+	type tmp Transfer
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Transfer(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+
+	return err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Transfer object out of the commonResult object.
+func (r commonResult) Extract() (*Transfer, error) {
+	var s struct {
+		Transfer *Transfer `json:"transfer"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Transfer, err
+}
+
+// CreateResult contains the response body and error from a Create or Accept
+// request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ExtractTransfers extracts and returns Transfers. It is used while iterating
+// over a volumetransfers.List call.
+func ExtractTransfers(r pagination.Page) ([]Transfer, error) {
+	var s struct {
+		Transfers []Transfer `json:"transfers"`
+	}
+	err := (r.(TransferPage)).ExtractInto(&s)
+	return s.Transfers, err
+}
+
+// TransferPage is a pagination.Pager that is returned from a call to the List
+// function.
+type TransferPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a TransferPage contains no Transfers.
+func (r TransferPage) IsEmpty() (bool, error) {
+	transfers, err := ExtractTransfers(r)
+	return len(transfers) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (page TransferPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"transfers_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumetransfers"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestCreateTransfer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateTransfer(t)
+
+	actual, err := volumetransfers.Create(fakeclient.ServiceClient(), TransferRequest).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, TransferResponse, *actual)
+}
+
+func TestAcceptTransfer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleAcceptTransfer(t)
+
+	actual, err := volumetransfers.Accept(fakeclient.ServiceClient(), TransferResponse.ID, AcceptRequest).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, AcceptResponse, *actual)
+}
+
+func TestDeleteTransfer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDeleteTransfer(t)
+
+	err := volumetransfers.Delete(fakeclient.ServiceClient(), TransferResponse.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListTransfers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleListTransfers(t)
+
+	transfer := TransferResponse
+	transfer.AuthKey = ""
+	expectedResponse := []volumetransfers.Transfer{transfer}
+
+	count := 0
+	err := volumetransfers.List(fakeclient.ServiceClient(), &volumetransfers.ListOpts{AllTenants: true}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := volumetransfers.ExtractTransfers(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, expectedResponse, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetTransfer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetTransfer(t)
+
+	expectedResponse := TransferResponse
+	expectedResponse.AuthKey = ""
+
+	actual, err := volumetransfers.Get(fakeclient.ServiceClient(), TransferResponse.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedResponse, *actual)
+}
@@ -1,9 +1,11 @@
 package testing
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v1/snapshots"
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -114,3 +116,17 @@ func TestDelete(t *testing.T) {
 	res := snapshots.Delete(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestWaitForStatusZeroTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %s", r.URL)
+	})
+
+	err := snapshots.WaitForStatus(client.ServiceClient(), "1234", "available", 0)
+	if _, ok := err.(gophercloud.ErrTimeOut); !ok {
+		t.Fatalf("Expected ErrTimeOut, got %#v", err)
+	}
+}
@@ -1,9 +1,11 @@
 package testing
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v1/volumes"
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -150,3 +152,17 @@ func TestUpdate(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, "vol-002", v.Name)
 }
+
+func TestWaitForStatusZeroTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %s", r.URL)
+	})
+
+	err := volumes.WaitForStatus(client.ServiceClient(), "1234", "available", 0)
+	if _, ok := err.(gophercloud.ErrTimeOut); !ok {
+		t.Fatalf("Expected ErrTimeOut, got %#v", err)
+	}
+}
@@ -1,22 +1,51 @@
 package volumes
 
 import (
+	"context"
+	"time"
+
 	"github.com/gophercloud/gophercloud"
 )
 
 // WaitForStatus will continually poll the resource, checking for a particular
-// status. It will do this for the amount of seconds defined.
+// status. It will do this for the amount of seconds defined, and stops early
+// if the volume goes into an error status.
+// A negative secs waits without a timeout.
 func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
-	return gophercloud.WaitFor(secs, func() (bool, error) {
-		current, err := Get(c, id).Extract()
-		if err != nil {
-			return false, err
-		}
+	if secs == 0 {
+		return gophercloud.ErrTimeOut{}
+	}
 
-		if current.Status == status {
-			return true, nil
-		}
+	w := NewWaiter(c, id, status)
+	w.Interval = time.Second
+	w.MaxInterval = time.Second
+	if secs > 0 {
+		w.Timeout = time.Duration(secs) * time.Second
+	}
+	_, err := w.Wait(context.Background())
+	return err
+}
 
-		return false, nil
-	})
+// NewWaiter returns a Waiter which polls a volume until its status is one of
+// target. The error statuses of a volume are failures. The Wait method of
+// the Waiter returns the volume as a *Volume.
+func NewWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.Waiter {
+	return &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			v, err := Get(c.WithContext(ctx), id).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return v, v.Status, nil
+		},
+		Target: target,
+		Failure: []string{
+			"error",
+			"error_deleting",
+			"error_backing-up",
+			"error_restoring",
+			"error_extending",
+			"error_managing",
+		},
+	}
 }
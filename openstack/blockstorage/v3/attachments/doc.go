@@ -0,0 +1,85 @@
+/*
+Package attachments provides access to the volume attachments API of the
+OpenStack Block Storage service. It replaces the reserve,
+initialize_connection and attach actions of the volumeactions package.
+
+The attachments API requires microversion 3.27 or later, which must be set on
+the Block Storage v3 client.
+
+Example to Create an Attachment
+
+	client.Microversion = "3.27"
+
+	createOpts := &attachments.CreateOpts{
+		InstanceUUID: "83ec2e3b-4321-422b-8706-a84185f52a0a",
+		Connector: map[string]interface{}{
+			"initiator":  "iqn.1993-08.org.debian:01:cad181614cec",
+			"ip":         "192.168.1.20",
+			"platform":   "x86_64",
+			"host":       "tempest-1",
+			"os_type":    "linux2",
+			"multipath":  false,
+			"mountpoint": "/dev/vdb",
+			"mode":       "rw",
+		},
+		VolumeUUID: "289da7f8-6440-407c-9fb4-7db01ec49164",
+	}
+
+	attachment, err := attachments.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Attachment with a connector
+
+	updateOpts := &attachments.UpdateOpts{
+		Connector: map[string]interface{}{
+			"initiator": "iqn.1993-08.org.debian:01:cad181614cec",
+			"host":      "tempest-1",
+		},
+	}
+
+	attachment, err := attachments.Update(client, attachmentID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(attachment.ConnectionInfo)
+
+Example to Complete an Attachment
+
+	client.Microversion = "3.44"
+
+	err := attachments.Complete(client, attachmentID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Attachments
+
+	listOpts := &attachments.ListOpts{
+		InstanceID: "83ec2e3b-4321-422b-8706-a84185f52a0a",
+	}
+
+	allPages, err := attachments.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAttachments, err := attachments.ExtractAttachments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, attachment := range allAttachments {
+		fmt.Println(attachment)
+	}
+
+Example to Delete an Attachment
+
+	err := attachments.Delete(client, attachmentID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package attachments
@@ -0,0 +1,185 @@
+package attachments
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// AttachMode describes the attachment mode of a volume.
+type AttachMode string
+
+// These constants determine how a volume is attached.
+const (
+	ReadOnly  AttachMode = "ro"
+	ReadWrite AttachMode = "rw"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAttachmentCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for creating an Attachment. This object is
+// passed to the attachments.Create function.
+type CreateOpts struct {
+	// VolumeUUID is the UUID of the volume to attach.
+	VolumeUUID string `json:"volume_uuid" required:"true"`
+
+	// InstanceUUID is the UUID of the server the volume is attached to.
+	InstanceUUID string `json:"instance_uuid" required:"true"`
+
+	// Connector is the connector information of the host the volume is
+	// attached to. If omitted, the attachment is only reserved and the
+	// connector must be provided later with Update.
+	Connector map[string]interface{} `json:"connector,omitempty"`
+
+	// Mode is the attach mode of the volume. This requires microversion 3.54
+	// or later.
+	Mode AttachMode `json:"mode,omitempty"`
+}
+
+// ToAttachmentCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToAttachmentCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "attachment")
+}
+
+// Create will create a new Attachment based on the values in CreateOpts. To
+// extract the Attachment object from the response, call the Extract method on
+// the CreateResult.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAttachmentCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete will delete the existing Attachment with the provided ID, which
+// detaches the volume.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Get retrieves the Attachment with the provided ID. To extract the
+// Attachment object from the response, call the Extract method on the
+// GetResult.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToAttachmentListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Attachments. It is passed to the
+// attachments.List function.
+type ListOpts struct {
+	// AllTenants will retrieve attachments of all tenants/projects.
+	AllTenants bool `q:"all_tenants"`
+
+	// Status will filter by the specified status.
+	Status string `q:"status"`
+
+	// ProjectID will filter by a specific tenant/project ID.
+	ProjectID string `q:"project_id"`
+
+	// VolumeID will filter by the attached volume.
+	VolumeID string `q:"volume_id"`
+
+	// InstanceID will filter by the server the volume is attached to.
+	InstanceID string `q:"instance_id"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToAttachmentListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAttachmentListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Attachments optionally limited by the conditions provided in
+// ListOpts.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToAttachmentListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return AttachmentPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToAttachmentUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values used when updating an Attachment.
+type UpdateOpts struct {
+	// Connector is the connector information of the host the volume is
+	// attached to.
+	Connector map[string]interface{} `json:"connector" required:"true"`
+}
+
+// ToAttachmentUpdateMap assembles a request body based on the contents of an
+// UpdateOpts.
+func (opts UpdateOpts) ToAttachmentUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "attachment")
+}
+
+// Update will update a reserved Attachment with the connector of the host,
+// which initializes the connection. The connection information needed by the
+// host is returned in the ConnectionInfo of the Attachment.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAttachmentUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Complete will mark the Attachment as completed, which sets the volume
+// status to "in-use". This requires microversion 3.44 or later.
+func Complete(client *gophercloud.ServiceClient, id string) (r CompleteResult) {
+	b := map[string]interface{}{
+		"os-complete": nil,
+	}
+	_, r.Err = client.Post(completeURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,2 @@
+// attachments unit tests
+package testing
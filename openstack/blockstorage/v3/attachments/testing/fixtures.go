@@ -0,0 +1,155 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/attachments"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+var (
+	attachedAt, _      = time.Parse(time.RFC3339, "2015-09-16T09:28:52Z")
+	expectedAttachment = &attachments.Attachment{
+		ID:         "05551600-a936-4d4a-ba42-79a037c1-c91a",
+		VolumeID:   "289da7f8-6440-407c-9fb4-7db01ec49164",
+		Instance:   "83ec2e3b-4321-422b-8706-a84185f52a0a",
+		AttachMode: "rw",
+		AttachedAt: attachedAt,
+		Status:     "attaching",
+		ConnectionInfo: map[string]interface{}{
+			"driver_volume_type": "iscsi",
+			"target_portal":      "192.168.1.10:3260",
+		},
+	}
+)
+
+const attachmentBody = `
+    {
+      "id": "05551600-a936-4d4a-ba42-79a037c1-c91a",
+      "volume_id": "289da7f8-6440-407c-9fb4-7db01ec49164",
+      "instance": "83ec2e3b-4321-422b-8706-a84185f52a0a",
+      "attach_mode": "rw",
+      "attached_at": "2015-09-16T09:28:52.000000",
+      "detached_at": null,
+      "status": "attaching",
+      "connection_info": {
+        "driver_volume_type": "iscsi",
+        "target_portal": "192.168.1.10:3260"
+      }
+    }
+`
+
+func MockListResponse(t *testing.T) {
+	th.Mux.HandleFunc("/attachments/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			th.TestFormValues(t, r, map[string]string{"instance_id": "83ec2e3b-4321-422b-8706-a84185f52a0a"})
+			fmt.Fprintf(w, `
+  {
+    "attachments": [%s],
+    "attachments_links": [
+      {
+        "href": "%s/attachments/detail?marker=1",
+        "rel": "next"
+      }
+    ]
+  }
+  `, attachmentBody, th.Server.URL)
+		case "1":
+			fmt.Fprintf(w, `{"attachments": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func MockGetResponse(t *testing.T) {
+	th.Mux.HandleFunc("/attachments/05551600-a936-4d4a-ba42-79a037c1-c91a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"attachment": %s}`, attachmentBody)
+	})
+}
+
+func MockCreateResponse(t *testing.T) {
+	th.Mux.HandleFunc("/attachments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "attachment": {
+        "instance_uuid": "83ec2e3b-4321-422b-8706-a84185f52a0a",
+        "connector": {
+            "initiator": "iqn.1993-08.org.debian:01:cad181614cec",
+            "host": "tempest-1"
+        },
+        "volume_uuid": "289da7f8-6440-407c-9fb4-7db01ec49164",
+        "mode": "rw"
+    }
+}
+      `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"attachment": %s}`, attachmentBody)
+	})
+}
+
+func MockUpdateResponse(t *testing.T) {
+	th.Mux.HandleFunc("/attachments/05551600-a936-4d4a-ba42-79a037c1-c91a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "attachment": {
+        "connector": {
+            "initiator": "iqn.1993-08.org.debian:01:cad181614cec",
+            "host": "tempest-1"
+        }
+    }
+}
+      `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"attachment": %s}`, attachmentBody)
+	})
+}
+
+func MockDeleteResponse(t *testing.T) {
+	th.Mux.HandleFunc("/attachments/05551600-a936-4d4a-ba42-79a037c1-c91a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func MockCompleteResponse(t *testing.T) {
+	th.Mux.HandleFunc("/attachments/05551600-a936-4d4a-ba42-79a037c1-c91a/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "os-complete": null
+}
+      `)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
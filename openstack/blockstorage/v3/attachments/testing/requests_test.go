@@ -0,0 +1,111 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/attachments"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+var connector = map[string]interface{}{
+	"initiator": "iqn.1993-08.org.debian:01:cad181614cec",
+	"host":      "tempest-1",
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockListResponse(t)
+
+	listOpts := attachments.ListOpts{
+		InstanceID: "83ec2e3b-4321-422b-8706-a84185f52a0a",
+	}
+
+	count := 0
+	err := attachments.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := attachments.ExtractAttachments(page)
+		if err != nil {
+			t.Errorf("Failed to extract attachments: %v", err)
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, []attachments.Attachment{*expectedAttachment}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetResponse(t)
+
+	attachment, err := attachments.Get(client.ServiceClient(), "05551600-a936-4d4a-ba42-79a037c1-c91a").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedAttachment, attachment)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockCreateResponse(t)
+
+	options := &attachments.CreateOpts{
+		InstanceUUID: "83ec2e3b-4321-422b-8706-a84185f52a0a",
+		Connector:    connector,
+		VolumeUUID:   "289da7f8-6440-407c-9fb4-7db01ec49164",
+		Mode:         attachments.ReadWrite,
+	}
+	attachment, err := attachments.Create(client.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedAttachment, attachment)
+}
+
+func TestCreateRequiredFields(t *testing.T) {
+	_, err := attachments.CreateOpts{VolumeUUID: "289da7f8-6440-407c-9fb4-7db01ec49164"}.ToAttachmentCreateMap()
+	if err == nil {
+		t.Fatal("Expected an error when InstanceUUID is missing")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockUpdateResponse(t)
+
+	options := &attachments.UpdateOpts{
+		Connector: connector,
+	}
+	attachment, err := attachments.Update(client.ServiceClient(), "05551600-a936-4d4a-ba42-79a037c1-c91a", options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedAttachment, attachment)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockDeleteResponse(t)
+
+	res := attachments.Delete(client.ServiceClient(), "05551600-a936-4d4a-ba42-79a037c1-c91a")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestComplete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockCompleteResponse(t)
+
+	err := attachments.Complete(client.ServiceClient(), "05551600-a936-4d4a-ba42-79a037c1-c91a").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,26 @@
+package attachments
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// NewWaiter returns a Waiter which polls an attachment until its status is
+// one of target, such as "attached" once the connection of a reserved
+// attachment is initialized. The error statuses of an attachment are
+// failures. The Wait method of the Waiter returns the attachment as an
+// *Attachment.
+func NewWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.Waiter {
+	return &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			v, err := Get(c.WithContext(ctx), id).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return v, v.Status, nil
+		},
+		Target:  target,
+		Failure: []string{"error_attaching", "error_detaching"},
+	}
+}
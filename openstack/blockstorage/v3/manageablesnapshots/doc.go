@@ -0,0 +1,56 @@
+/*
+Package manageablesnapshots provides access to the snapshots of a Block
+Storage backend which are not managed by the Block Storage service, and
+allows them to be managed and unmanaged. The volume of a snapshot must be
+managed before the snapshot itself; see the manageablevolumes package.
+
+Listing manageable snapshots requires microversion 3.8 or later. Manage and
+Unmanage are available on all microversions.
+
+Example to List the Manageable Snapshots of a Host
+
+	client.Microversion = "3.8"
+
+	listOpts := manageablesnapshots.ListOpts{
+		Host: "cinder-volume-1@lvm",
+	}
+
+	allPages, err := manageablesnapshots.ListDetail(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allSnapshots, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, snapshot := range allSnapshots {
+		fmt.Println(snapshot.Reference, snapshot.SourceReference)
+	}
+
+Example to Manage a Snapshot
+
+	manageOpts := manageablesnapshots.ManageOpts{
+		VolumeID: "c7e1c0a2-9a3e-4e2b-a4f6-0cc6e3b7bd85",
+		Ref: map[string]string{
+			"source-name": "existing-snapshot",
+		},
+		Name: "managed-snapshot",
+	}
+
+	snapshot, err := manageablesnapshots.Manage(client, manageOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Unmanage a Snapshot
+
+	snapshotID := "3b0e9f4a-29a6-41d2-95a0-1f9c3b2b1f5e"
+
+	err := manageablesnapshots.Unmanage(client, snapshotID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package manageablesnapshots
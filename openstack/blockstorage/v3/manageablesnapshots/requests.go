@@ -0,0 +1,126 @@
+package manageablesnapshots
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToManageableSnapshotListQuery() (string, error)
+}
+
+// ListOpts holds options for listing the snapshots of a backend which can be
+// managed. It is passed to the manageablesnapshots.List and
+// manageablesnapshots.ListDetail functions. Either Host or Cluster must be
+// given.
+type ListOpts struct {
+	// Host is the cinder-volume host, in the form of host@backend, whose
+	// snapshots are listed.
+	Host string `q:"host"`
+
+	// Cluster is the cluster whose snapshots are listed. This requires
+	// microversion 3.17 or later.
+	Cluster string `q:"cluster"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The reference of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToManageableSnapshotListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToManageableSnapshotListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a summary of the snapshots of a backend which can be managed.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns the details of the snapshots of a backend which can be
+// managed, including whether they are safe to manage.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToManageableSnapshotListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ManageableSnapshotPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ManageOptsBuilder allows extensions to add additional parameters to the
+// Manage request.
+type ManageOptsBuilder interface {
+	ToSnapshotManageMap() (map[string]interface{}, error)
+}
+
+// ManageOpts contains options for managing an existing backend snapshot.
+type ManageOpts struct {
+	// VolumeID is the ID of the managed volume the snapshot belongs to.
+	VolumeID string `json:"volume_id" required:"true"`
+
+	// Ref is the reference of the snapshot on the backend, such as
+	// {"source-name": "existing-snapshot"}.
+	Ref map[string]string `json:"ref" required:"true"`
+
+	// Name is the name of the managed snapshot.
+	Name string `json:"name,omitempty"`
+
+	// Description is the description of the managed snapshot.
+	Description string `json:"description,omitempty"`
+
+	// Metadata is the metadata of the managed snapshot.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ToSnapshotManageMap assembles a request body based on the contents of a
+// ManageOpts.
+func (opts ManageOpts) ToSnapshotManageMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "snapshot")
+}
+
+// Manage brings an existing backend snapshot under the management of the
+// Block Storage service. To extract the Snapshot from the response, call the
+// Extract method on the ManageResult.
+func Manage(client *gophercloud.ServiceClient, opts ManageOptsBuilder) (r ManageResult) {
+	b, err := opts.ToSnapshotManageMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(manageURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Unmanage removes a snapshot from the management of the Block Storage
+// service without deleting it from the backend.
+func Unmanage(client *gophercloud.ServiceClient, id string) (r UnmanageResult) {
+	b := map[string]interface{}{"os-unmanage": make(map[string]interface{})}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,78 @@
+package manageablesnapshots
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ManageableSnapshot represents a snapshot of a backend which can be managed.
+type ManageableSnapshot struct {
+	// Reference is the reference of the snapshot on the backend, which is
+	// passed as Ref to Manage.
+	Reference map[string]interface{} `json:"reference"`
+
+	// SourceReference is the reference of the volume the snapshot was taken
+	// from.
+	SourceReference map[string]interface{} `json:"source_reference"`
+
+	// Size is the size of the snapshot in GB.
+	Size int `json:"size"`
+
+	// SafeToManage indicates whether the snapshot can be managed.
+	SafeToManage bool `json:"safe_to_manage"`
+
+	// ReasonNotSafe is the reason the snapshot cannot be managed. It is only
+	// returned by ListDetail.
+	ReasonNotSafe string `json:"reason_not_safe"`
+
+	// CinderID is the ID of the snapshot if it is already managed. It is only
+	// returned by ListDetail.
+	CinderID string `json:"cinder_id"`
+
+	// ExtraInfo holds backend specific information about the snapshot. It is
+	// only returned by ListDetail.
+	ExtraInfo map[string]interface{} `json:"extra_info"`
+}
+
+// ManageableSnapshotPage is a pagination.Pager that is returned from a call
+// to the List or ListDetail functions.
+type ManageableSnapshotPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty returns true if a ManageableSnapshotPage contains no snapshots.
+func (r ManageableSnapshotPage) IsEmpty() (bool, error) {
+	snapshots, err := ExtractManageableSnapshots(r)
+	return len(snapshots) == 0, err
+}
+
+// ExtractManageableSnapshots extracts and returns ManageableSnapshots. It is
+// used while iterating over a manageablesnapshots.List or ListDetail call.
+func ExtractManageableSnapshots(r pagination.Page) ([]ManageableSnapshot, error) {
+	var s struct {
+		ManageableSnapshots []ManageableSnapshot `json:"manageable-snapshots"`
+	}
+	err := (r.(ManageableSnapshotPage)).ExtractInto(&s)
+	return s.ManageableSnapshots, err
+}
+
+// ManageResult contains the response body and error from a Manage request.
+type ManageResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the managed Snapshot out of the ManageResult object.
+func (r ManageResult) Extract() (*snapshots.Snapshot, error) {
+	var s struct {
+		Snapshot *snapshots.Snapshot `json:"snapshot"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Snapshot, err
+}
+
+// UnmanageResult contains the response body and error from an Unmanage
+// request.
+type UnmanageResult struct {
+	gophercloud.ErrResult
+}
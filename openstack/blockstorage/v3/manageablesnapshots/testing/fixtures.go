@@ -0,0 +1,89 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func MockListDetailResponse(t *testing.T) {
+	th.Mux.HandleFunc("/manageable_snapshots/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"host": "cinder-volume-1@lvm"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+    "manageable-snapshots": [
+        {
+            "cinder_id": null,
+            "reason_not_safe": null,
+            "safe_to_manage": true,
+            "reference": {
+                "source-name": "existing-snapshot"
+            },
+            "source_reference": {
+                "source-name": "existing-volume"
+            },
+            "size": 1,
+            "extra_info": null
+        }
+    ]
+}
+		`)
+	})
+}
+
+func MockManageResponse(t *testing.T) {
+	th.Mux.HandleFunc("/os-snapshot-manage", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "snapshot": {
+        "volume_id": "c7e1c0a2-9a3e-4e2b-a4f6-0cc6e3b7bd85",
+        "ref": {
+            "source-name": "existing-snapshot"
+        },
+        "name": "managed-snapshot"
+    }
+}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `
+{
+    "snapshot": {
+        "id": "3b0e9f4a-29a6-41d2-95a0-1f9c3b2b1f5e",
+        "status": "creating",
+        "size": 1,
+        "name": "managed-snapshot",
+        "volume_id": "c7e1c0a2-9a3e-4e2b-a4f6-0cc6e3b7bd85",
+        "created_at": "2019-01-02T03:04:05.000000",
+        "metadata": {}
+    }
+}
+		`)
+	})
+}
+
+func MockUnmanageResponse(t *testing.T) {
+	th.Mux.HandleFunc("/snapshots/3b0e9f4a-29a6-41d2-95a0-1f9c3b2b1f5e/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "os-unmanage": {}
+}
+		`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,62 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/manageablesnapshots"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockListDetailResponse(t)
+
+	allPages, err := manageablesnapshots.ListDetail(client.ServiceClient(), manageablesnapshots.ListOpts{Host: "cinder-volume-1@lvm"}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []manageablesnapshots.ManageableSnapshot{
+		{
+			SafeToManage:    true,
+			Reference:       map[string]interface{}{"source-name": "existing-snapshot"},
+			SourceReference: map[string]interface{}{"source-name": "existing-volume"},
+			Size:            1,
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestManage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockManageResponse(t)
+
+	options := manageablesnapshots.ManageOpts{
+		VolumeID: "c7e1c0a2-9a3e-4e2b-a4f6-0cc6e3b7bd85",
+		Ref: map[string]string{
+			"source-name": "existing-snapshot",
+		},
+		Name: "managed-snapshot",
+	}
+	s, err := manageablesnapshots.Manage(client.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, "3b0e9f4a-29a6-41d2-95a0-1f9c3b2b1f5e", s.ID)
+	th.CheckEquals(t, "creating", s.Status)
+	th.CheckEquals(t, "c7e1c0a2-9a3e-4e2b-a4f6-0cc6e3b7bd85", s.VolumeID)
+}
+
+func TestUnmanage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockUnmanageResponse(t)
+
+	err := manageablesnapshots.Unmanage(client.ServiceClient(), "3b0e9f4a-29a6-41d2-95a0-1f9c3b2b1f5e").ExtractErr()
+	th.AssertNoErr(t, err)
+}
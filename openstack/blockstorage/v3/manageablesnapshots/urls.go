@@ -0,0 +1,19 @@
+package manageablesnapshots
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("manageable_snapshots")
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("manageable_snapshots", "detail")
+}
+
+func manageURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("os-snapshot-manage")
+}
+
+func actionURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("snapshots", id, "action")
+}
@@ -0,0 +1,57 @@
+/*
+Package manageablevolumes provides access to the volumes of a Block Storage
+backend which are not managed by the Block Storage service, and allows them
+to be managed and unmanaged.
+
+Listing manageable volumes requires microversion 3.8 or later. Manage and
+Unmanage are available on all microversions.
+
+Example to List the Manageable Volumes of a Host
+
+	client.Microversion = "3.8"
+
+	listOpts := manageablevolumes.ListOpts{
+		Host: "cinder-volume-1@lvm",
+	}
+
+	allPages, err := manageablevolumes.ListDetail(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allVolumes, err := manageablevolumes.ExtractManageableVolumes(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, volume := range allVolumes {
+		if volume.SafeToManage {
+			fmt.Println(volume.Reference)
+		}
+	}
+
+Example to Manage a Volume
+
+	manageOpts := manageablevolumes.ManageOpts{
+		Host: "cinder-volume-1@lvm#lvm",
+		Ref: map[string]string{
+			"source-name": "existing-volume",
+		},
+		Name: "managed-volume",
+	}
+
+	volume, err := manageablevolumes.Manage(client, manageOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Unmanage a Volume
+
+	volumeID := "2ef4cb04-1abd-4e33-a0e2-f4de4e3b6a8d"
+
+	err := manageablevolumes.Unmanage(client, volumeID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package manageablevolumes
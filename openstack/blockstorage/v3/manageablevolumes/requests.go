@@ -0,0 +1,145 @@
+package manageablevolumes
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToManageableVolumeListQuery() (string, error)
+}
+
+// ListOpts holds options for listing the volumes of a backend which can be
+// managed. It is passed to the manageablevolumes.List and
+// manageablevolumes.ListDetail functions. Either Host or Cluster must be
+// given.
+type ListOpts struct {
+	// Host is the cinder-volume host, in the form of host@backend, whose
+	// volumes are listed.
+	Host string `q:"host"`
+
+	// Cluster is the cluster whose volumes are listed. This requires
+	// microversion 3.17 or later.
+	Cluster string `q:"cluster"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The reference of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToManageableVolumeListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToManageableVolumeListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a summary of the volumes of a backend which can be managed.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns the details of the volumes of a backend which can be
+// managed, including whether they are safe to manage.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToManageableVolumeListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ManageableVolumePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ManageOptsBuilder allows extensions to add additional parameters to the
+// Manage request.
+type ManageOptsBuilder interface {
+	ToVolumeManageMap() (map[string]interface{}, error)
+}
+
+// ManageOpts contains options for managing an existing backend volume.
+type ManageOpts struct {
+	// Host is the cinder-volume host, in the form of host@backend#pool, of the
+	// volume.
+	Host string `json:"host,omitempty"`
+
+	// Cluster is the cluster of the volume. This requires microversion 3.16
+	// or later.
+	Cluster string `json:"cluster,omitempty"`
+
+	// Ref is the reference of the volume on the backend, such as
+	// {"source-name": "existing-volume"}.
+	Ref map[string]string `json:"ref" required:"true"`
+
+	// Name is the name of the managed volume.
+	Name string `json:"name,omitempty"`
+
+	// Description is the description of the managed volume.
+	Description string `json:"description,omitempty"`
+
+	// VolumeType is the volume type of the managed volume.
+	VolumeType string `json:"volume_type,omitempty"`
+
+	// AvailabilityZone is the availability zone of the managed volume.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+
+	// Bootable indicates whether the managed volume is bootable.
+	Bootable bool `json:"bootable,omitempty"`
+
+	// Metadata is the metadata of the managed volume.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ToVolumeManageMap assembles a request body based on the contents of a
+// ManageOpts.
+func (opts ManageOpts) ToVolumeManageMap() (map[string]interface{}, error) {
+	if opts.Host == "" && opts.Cluster == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "manageablevolumes.ManageOpts.Host/manageablevolumes.ManageOpts.Cluster"
+		return nil, err
+	}
+	return gophercloud.BuildRequestBody(opts, "volume")
+}
+
+// Manage brings an existing backend volume under the management of the Block
+// Storage service. To extract the Volume from the response, call the Extract
+// method on the ManageResult.
+func Manage(client *gophercloud.ServiceClient, opts ManageOptsBuilder) (r ManageResult) {
+	b, err := opts.ToVolumeManageMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(manageURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Unmanage removes a volume from the management of the Block Storage service
+// without deleting it from the backend.
+func Unmanage(client *gophercloud.ServiceClient, id string) (r UnmanageResult) {
+	b := map[string]interface{}{"os-unmanage": make(map[string]interface{})}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,74 @@
+package manageablevolumes
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ManageableVolume represents a volume of a backend which can be managed.
+type ManageableVolume struct {
+	// Reference is the reference of the volume on the backend, which is
+	// passed as Ref to Manage.
+	Reference map[string]interface{} `json:"reference"`
+
+	// Size is the size of the volume in GB.
+	Size int `json:"size"`
+
+	// SafeToManage indicates whether the volume can be managed.
+	SafeToManage bool `json:"safe_to_manage"`
+
+	// ReasonNotSafe is the reason the volume cannot be managed. It is only
+	// returned by ListDetail.
+	ReasonNotSafe string `json:"reason_not_safe"`
+
+	// CinderID is the ID of the volume if it is already managed. It is only
+	// returned by ListDetail.
+	CinderID string `json:"cinder_id"`
+
+	// ExtraInfo holds backend specific information about the volume. It is
+	// only returned by ListDetail.
+	ExtraInfo map[string]interface{} `json:"extra_info"`
+}
+
+// ManageableVolumePage is a pagination.Pager that is returned from a call to
+// the List or ListDetail functions.
+type ManageableVolumePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty returns true if a ManageableVolumePage contains no volumes.
+func (r ManageableVolumePage) IsEmpty() (bool, error) {
+	volumes, err := ExtractManageableVolumes(r)
+	return len(volumes) == 0, err
+}
+
+// ExtractManageableVolumes extracts and returns ManageableVolumes. It is used
+// while iterating over a manageablevolumes.List or ListDetail call.
+func ExtractManageableVolumes(r pagination.Page) ([]ManageableVolume, error) {
+	var s struct {
+		ManageableVolumes []ManageableVolume `json:"manageable-volumes"`
+	}
+	err := (r.(ManageableVolumePage)).ExtractInto(&s)
+	return s.ManageableVolumes, err
+}
+
+// ManageResult contains the response body and error from a Manage request.
+type ManageResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the managed Volume out of the ManageResult object.
+func (r ManageResult) Extract() (*volumes.Volume, error) {
+	var s struct {
+		Volume *volumes.Volume `json:"volume"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Volume, err
+}
+
+// UnmanageResult contains the response body and error from an Unmanage
+// request.
+type UnmanageResult struct {
+	gophercloud.ErrResult
+}
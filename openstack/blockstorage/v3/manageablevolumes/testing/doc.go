@@ -0,0 +1,2 @@
+// manageablevolumes unit tests
+package testing
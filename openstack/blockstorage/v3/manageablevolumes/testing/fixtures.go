@@ -0,0 +1,122 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func MockListResponse(t *testing.T) {
+	th.Mux.HandleFunc("/manageable_volumes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"host": "cinder-volume-1@lvm"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+    "manageable-volumes": [
+        {
+            "safe_to_manage": true,
+            "reference": {
+                "source-name": "existing-volume"
+            },
+            "size": 1
+        }
+    ]
+}
+		`)
+	})
+}
+
+func MockListDetailResponse(t *testing.T) {
+	th.Mux.HandleFunc("/manageable_volumes/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"host": "cinder-volume-1@lvm"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+    "manageable-volumes": [
+        {
+            "cinder_id": null,
+            "reason_not_safe": null,
+            "safe_to_manage": true,
+            "reference": {
+                "source-name": "existing-volume"
+            },
+            "size": 1,
+            "extra_info": null
+        },
+        {
+            "cinder_id": "2ef4cb04-1abd-4e33-a0e2-f4de4e3b6a8d",
+            "reason_not_safe": "already managed",
+            "safe_to_manage": false,
+            "reference": {
+                "source-name": "volume-2ef4cb04-1abd-4e33-a0e2-f4de4e3b6a8d"
+            },
+            "size": 2,
+            "extra_info": null
+        }
+    ]
+}
+		`)
+	})
+}
+
+func MockManageResponse(t *testing.T) {
+	th.Mux.HandleFunc("/os-volume-manage", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "volume": {
+        "host": "cinder-volume-1@lvm#lvm",
+        "ref": {
+            "source-name": "existing-volume"
+        },
+        "name": "managed-volume",
+        "bootable": true
+    }
+}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `
+{
+    "volume": {
+        "id": "c7e1c0a2-9a3e-4e2b-a4f6-0cc6e3b7bd85",
+        "status": "creating",
+        "size": 0,
+        "name": "managed-volume",
+        "bootable": "true",
+        "availability_zone": "nova",
+        "created_at": "2019-01-02T03:04:05.000000",
+        "metadata": {}
+    }
+}
+		`)
+	})
+}
+
+func MockUnmanageResponse(t *testing.T) {
+	th.Mux.HandleFunc("/volumes/2ef4cb04-1abd-4e33-a0e2-f4de4e3b6a8d/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "os-unmanage": {}
+}
+		`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,104 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/manageablevolumes"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockListResponse(t)
+
+	allPages, err := manageablevolumes.List(client.ServiceClient(), manageablevolumes.ListOpts{Host: "cinder-volume-1@lvm"}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := manageablevolumes.ExtractManageableVolumes(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []manageablevolumes.ManageableVolume{
+		{
+			SafeToManage: true,
+			Reference:    map[string]interface{}{"source-name": "existing-volume"},
+			Size:         1,
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestListDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockListDetailResponse(t)
+
+	allPages, err := manageablevolumes.ListDetail(client.ServiceClient(), manageablevolumes.ListOpts{Host: "cinder-volume-1@lvm"}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := manageablevolumes.ExtractManageableVolumes(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []manageablevolumes.ManageableVolume{
+		{
+			SafeToManage: true,
+			Reference:    map[string]interface{}{"source-name": "existing-volume"},
+			Size:         1,
+		},
+		{
+			CinderID:      "2ef4cb04-1abd-4e33-a0e2-f4de4e3b6a8d",
+			ReasonNotSafe: "already managed",
+			SafeToManage:  false,
+			Reference:     map[string]interface{}{"source-name": "volume-2ef4cb04-1abd-4e33-a0e2-f4de4e3b6a8d"},
+			Size:          2,
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestManage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockManageResponse(t)
+
+	options := manageablevolumes.ManageOpts{
+		Host: "cinder-volume-1@lvm#lvm",
+		Ref: map[string]string{
+			"source-name": "existing-volume",
+		},
+		Name:     "managed-volume",
+		Bootable: true,
+	}
+	v, err := manageablevolumes.Manage(client.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, "c7e1c0a2-9a3e-4e2b-a4f6-0cc6e3b7bd85", v.ID)
+	th.CheckEquals(t, "creating", v.Status)
+	th.CheckEquals(t, "managed-volume", v.Name)
+	th.CheckEquals(t, time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC), v.CreatedAt)
+}
+
+func TestManageRequiresHostOrCluster(t *testing.T) {
+	options := manageablevolumes.ManageOpts{
+		Ref: map[string]string{
+			"source-name": "existing-volume",
+		},
+	}
+	_, err := options.ToVolumeManageMap()
+	if err == nil {
+		t.Fatal("Expected an error when neither Host nor Cluster is set")
+	}
+}
+
+func TestUnmanage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockUnmanageResponse(t)
+
+	err := manageablevolumes.Unmanage(client.ServiceClient(), "2ef4cb04-1abd-4e33-a0e2-f4de4e3b6a8d").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,19 @@
+package manageablevolumes
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("manageable_volumes")
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("manageable_volumes", "detail")
+}
+
+func manageURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("os-volume-manage")
+}
+
+func actionURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("volumes", id, "action")
+}
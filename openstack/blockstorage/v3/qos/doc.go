@@ -0,0 +1,128 @@
+/*
+Package qos provides information and interaction with the QoS specifications
+for the Openstack Blockstorage service. A QoS specification limits the
+performance of the volumes of the volume types it is associated with.
+
+Example to create a QoS specification
+
+	createOpts := qos.CreateOpts{
+		Name:     "test",
+		Consumer: qos.ConsumerFront,
+		Specs: map[string]string{
+			"read_iops_sec": "20000",
+		},
+	}
+
+	test, err := qos.Create(client, createOpts).Extract()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Print(test)
+
+Example to delete a QoS specification
+
+	qosID := "d6ae28ce-fcb5-4180-aa62-d260a27e09ae"
+
+	deleteOpts := qos.DeleteOpts{
+		Force: false,
+	}
+
+	err = qos.Delete(client, qosID, deleteOpts).ExtractErr()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+Example to list QoS specifications
+
+	listOpts := qos.ListOpts{}
+
+	allPages, err := qos.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allQoS, err := qos.ExtractQoS(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, qos := range allQoS {
+		fmt.Printf("List: %+v\n", qos)
+	}
+
+Example to get a single QoS specification
+
+	qosID := "de075d5e-8afc-4e23-9388-b84a5183d1c0"
+
+	singleQos, err := qos.Get(client, qosID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Get: %+v\n", singleQos)
+
+Example of updating and deleting the keys of a QoS specification
+
+	updateOpts := qos.UpdateOpts{
+		Specs: map[string]string{
+			"read_iops_sec": "40000",
+		},
+	}
+
+	specs, err := qos.Update(client, qosID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = qos.DeleteKeys(client, qosID, qos.Keys{"read_iops_sec"}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of associating a QoS specification with a volume type
+
+	associateOpts := qos.AssociateOpts{
+		VolumeTypeID: "b596be6a-0ce9-43fa-804a-5c5e181ede76",
+	}
+
+	err = qos.Associate(client, qosID, associateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of disassociating a QoS specification from a volume type
+
+	disassociateOpts := qos.DisassociateOpts{
+		VolumeTypeID: "b596be6a-0ce9-43fa-804a-5c5e181ede76",
+	}
+
+	err = qos.Disassociate(client, qosID, disassociateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of disassociating a QoS specification from all volume types
+
+	err = qos.DisassociateAll(client, qosID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of listing all associations of a QoS specification
+
+	allQosAssociations, err := qos.ListAssociations(client, qosID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAssociations, err := qos.ExtractAssociations(allQosAssociations)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, association := range allAssociations {
+		fmt.Printf("Association: %+v\n", association)
+	}
+*/
+package qos
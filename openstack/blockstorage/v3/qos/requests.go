@@ -0,0 +1,305 @@
+package qos
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// QoSConsumer is the consumer of a QoS specs, which enforces it.
+type QoSConsumer string
+
+const (
+	ConsumerFront QoSConsumer = "front-end"
+	ConsumerBack  QoSConsumer = "back-end"
+	ConsumerBoth  QoSConsumer = "both"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToQoSCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for creating a QoS specification.
+// This object is passed to the qos.Create function.
+type CreateOpts struct {
+	// The name of the QoS spec
+	Name string `json:"name" required:"true"`
+	// The consumer of the QoS spec. Possible values are
+	// both, front-end, back-end.
+	Consumer QoSConsumer `json:"consumer,omitempty"`
+	// Specs is a collection of miscellaneous key/values used to set
+	// specifications for the QoS
+	Specs map[string]string `json:"-"`
+}
+
+// ToQoSCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToQoSCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "qos_specs")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Specs != nil {
+		if v, ok := b["qos_specs"].(map[string]interface{}); ok {
+			for key, value := range opts.Specs {
+				v[key] = value
+			}
+		}
+	}
+
+	return b, nil
+}
+
+// Create will create a new QoS based on the values in CreateOpts. To extract
+// the QoS object from the response, call the Extract method on the
+// CreateResult.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToQoSCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteOptsBuilder allows extensions to add additional parameters to the
+// Delete request.
+type DeleteOptsBuilder interface {
+	ToQoSDeleteQuery() (string, error)
+}
+
+// DeleteOpts contains options for deleting a QoS. This object is passed to
+// the qos.Delete function.
+type DeleteOpts struct {
+	// Delete a QoS specification even if it is in-use
+	Force bool `q:"force"`
+}
+
+// ToQoSDeleteQuery formats a DeleteOpts into a query string.
+func (opts DeleteOpts) ToQoSDeleteQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Delete will delete the existing QoS with the provided ID.
+func Delete(client *gophercloud.ServiceClient, id string, opts DeleteOptsBuilder) (r DeleteResult) {
+	url := deleteURL(client, id)
+	if opts != nil {
+		query, err := opts.ToQoSDeleteQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Delete(url, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToQoSListQuery() (string, error)
+}
+
+// ListOpts holds options for listing QoS specs. It is passed to the qos.List
+// function.
+type ListOpts struct {
+	// Sort is Comma-separated list of sort keys and optional sort
+	// directions in the form of < key > [: < direction > ]. A valid
+	// direction is asc (ascending) or desc (descending).
+	Sort string `q:"sort"`
+
+	// Marker and Limit control paging.
+	// Marker instructs List where to start listing from.
+	Marker string `q:"marker"`
+
+	// Limit instructs List to refrain from sending excessively large lists of
+	// QoS.
+	Limit int `q:"limit"`
+}
+
+// ToQoSListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToQoSListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List instructs OpenStack to provide a list of QoS.
+// You may provide criteria by which List curtails its results for easier
+// processing.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToQoSListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return QoSPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details of a single qos. Use Extract to convert its
+// result into a QoS.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToQoSUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains options for setting the specs of an existing QoS.
+// This object is passed to the qos.Update function.
+type UpdateOpts struct {
+	// The consumer of the QoS spec. Possible values are
+	// both, front-end, back-end.
+	Consumer QoSConsumer `json:"consumer,omitempty"`
+	// Specs is a collection of miscellaneous key/values used to set
+	// specifications for the QoS
+	Specs map[string]string `json:"-"`
+}
+
+// ToQoSUpdateMap assembles a request body based on the contents of an
+// UpdateOpts.
+func (opts UpdateOpts) ToQoSUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "qos_specs")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Specs != nil {
+		if v, ok := b["qos_specs"].(map[string]interface{}); ok {
+			for key, value := range opts.Specs {
+				v[key] = value
+			}
+		}
+	}
+
+	return b, nil
+}
+
+// Update will update an existing QoS based on the values in UpdateOpts.
+// To extract the QoS object from the response, call the Extract method
+// on the UpdateResult.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToQoSUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Keys is a slice of keys to remove from a QoS.
+type Keys []string
+
+// DeleteKeys will delete the keys from a QoS.
+func DeleteKeys(client *gophercloud.ServiceClient, qosID string, keys Keys) (r DeleteResult) {
+	_, r.Err = client.Put(deleteKeysURL(client, qosID), map[string]interface{}{"keys": keys}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// AssociateOptsBuilder allows extensions to define volume type id
+// to the associate query
+type AssociateOptsBuilder interface {
+	ToQosAssociateQuery() (string, error)
+}
+
+// AssociateOpts contains options for associating a QoS with a
+// volume type
+type AssociateOpts struct {
+	VolumeTypeID string `q:"vol_type_id" required:"true"`
+}
+
+// ToQosAssociateQuery formats an AssociateOpts into a query string
+func (opts AssociateOpts) ToQosAssociateQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Associate will associate a qos with a volume type
+func Associate(client *gophercloud.ServiceClient, qosID string, opts AssociateOptsBuilder) (r AssociateResult) {
+	url := associateURL(client, qosID)
+	query, err := opts.ToQosAssociateQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url += query
+
+	_, r.Err = client.Get(url, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// DisassociateOptsBuilder allows extensions to define volume type id
+// to the disassociate query
+type DisassociateOptsBuilder interface {
+	ToQosDisassociateQuery() (string, error)
+}
+
+// DisassociateOpts contains options for disassociating a QoS from a
+// volume type
+type DisassociateOpts struct {
+	VolumeTypeID string `q:"vol_type_id" required:"true"`
+}
+
+// ToQosDisassociateQuery formats a DisassociateOpts into a query string
+func (opts DisassociateOpts) ToQosDisassociateQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Disassociate will disassociate a qos from a volume type
+func Disassociate(client *gophercloud.ServiceClient, qosID string, opts DisassociateOptsBuilder) (r DisassociateResult) {
+	url := disassociateURL(client, qosID)
+	query, err := opts.ToQosDisassociateQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url += query
+
+	_, r.Err = client.Get(url, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// DisassociateAll will disassociate a qos from all volume types
+func DisassociateAll(client *gophercloud.ServiceClient, qosID string) (r DisassociateAllResult) {
+	_, r.Err = client.Get(disassociateAllURL(client, qosID), nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// ListAssociations retrieves the associations of a QoS.
+func ListAssociations(client *gophercloud.ServiceClient, qosID string) pagination.Pager {
+	url := listAssociationsURL(client, qosID)
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return AssociationPage{pagination.SinglePageBase(r)}
+	})
+}
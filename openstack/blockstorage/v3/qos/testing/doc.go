@@ -0,0 +1,2 @@
+// qos unit tests
+package testing
@@ -0,0 +1,43 @@
+package qos
+
+import "github.com/gophercloud/gophercloud"
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("qos-specs", id)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("qos-specs")
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return createURL(c)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("qos-specs", id)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("qos-specs", id)
+}
+
+func deleteKeysURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("qos-specs", id, "delete_keys")
+}
+
+func associateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("qos-specs", id, "associate")
+}
+
+func disassociateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("qos-specs", id, "disassociate")
+}
+
+func disassociateAllURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("qos-specs", id, "disassociate_all")
+}
+
+func listAssociationsURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("qos-specs", id, "associations")
+}
@@ -58,6 +58,67 @@ Example to update a Volume Type
 		panic(err)
 	}
 	fmt.Println(volumetype)
+
+Example to Create, Update and Delete Extra Specs of a Volume Type
+
+	typeID := "7ffaca22-f646-41d4-b79d-d7e4452ef8cc"
+	createOpts := volumetypes.ExtraSpecsOpts{
+		"capabilities":        "gpu",
+		"volume_backend_name": "ssd",
+	}
+	createdExtraSpecs, err := volumetypes.CreateExtraSpecs(client, typeID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	updateOpts := volumetypes.ExtraSpecsOpts{
+		"capabilities": "capabilities-updated",
+	}
+	updatedExtraSpec, err := volumetypes.UpdateExtraSpec(client, typeID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = volumetypes.DeleteExtraSpec(client, typeID, "capabilities").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Projects with Access to a private Volume Type
+
+	allPages, err := volumetypes.ListAccesses(client, typeID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAccesses, err := volumetypes.ExtractAccesses(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, access := range allAccesses {
+		fmt.Printf("%+v", access)
+	}
+
+Example to Grant and Revoke Access to a private Volume Type
+
+	accessOpts := volumetypes.AddAccessOpts{
+		Project: "15153a0979884b59b0592248ef947921",
+	}
+	err := volumetypes.AddAccess(client, typeID, accessOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	removeOpts := volumetypes.RemoveAccessOpts{
+		Project: "15153a0979884b59b0592248ef947921",
+	}
+	err = volumetypes.RemoveAccess(client, typeID, removeOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+The QoS specs associated with volume types are managed by the qos package.
 */
 
 package volumetypes
@@ -92,3 +92,103 @@ type DeleteResult struct {
 type UpdateResult struct {
 	commonResult
 }
+
+// extraSpecsResult contains the result of a call for (potentially) multiple
+// key-value pairs. Call its Extract method to interpret it as a
+// map[string]interface.
+type extraSpecsResult struct {
+	gophercloud.Result
+}
+
+// ListExtraSpecsResult contains the result of a Get operation. Call its Extract
+// method to interpret it as a map[string]interface.
+type ListExtraSpecsResult struct {
+	extraSpecsResult
+}
+
+// CreateExtraSpecsResult contains the result of a Create operation. Call its
+// Extract method to interpret it as a map[string]interface.
+type CreateExtraSpecsResult struct {
+	extraSpecsResult
+}
+
+// Extract interprets any extraSpecsResult as ExtraSpecs, if possible.
+func (r extraSpecsResult) Extract() (map[string]string, error) {
+	var s struct {
+		ExtraSpecs map[string]string `json:"extra_specs"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ExtraSpecs, err
+}
+
+// extraSpecResult contains the result of a call for individual a single
+// key-value pair.
+type extraSpecResult struct {
+	gophercloud.Result
+}
+
+// GetExtraSpecResult contains the result of a Get operation. Call its Extract
+// method to interpret it as a map[string]interface.
+type GetExtraSpecResult struct {
+	extraSpecResult
+}
+
+// UpdateExtraSpecResult contains the result of an Update operation. Call its
+// Extract method to interpret it as a map[string]interface.
+type UpdateExtraSpecResult struct {
+	extraSpecResult
+}
+
+// DeleteExtraSpecResult contains the result of a Delete operation. Call its
+// ExtractErr method to determine if the call succeeded or failed.
+type DeleteExtraSpecResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract interprets any extraSpecResult as an ExtraSpec, if possible.
+func (r extraSpecResult) Extract() (map[string]string, error) {
+	var s map[string]string
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// VolumeTypeAccess represents an ACL of project access to a specific Volume Type.
+type VolumeTypeAccess struct {
+	// VolumeTypeID is the unique ID of the volume type.
+	VolumeTypeID string `json:"volume_type_id"`
+
+	// ProjectID is the unique ID of the project.
+	ProjectID string `json:"project_id"`
+}
+
+// AccessPage contains a single page of all VolumeTypeAccess entries for a volume type.
+type AccessPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty indicates whether an AccessPage is empty.
+func (page AccessPage) IsEmpty() (bool, error) {
+	v, err := ExtractAccesses(page)
+	return len(v) == 0, err
+}
+
+// ExtractAccesses interprets a page of results as a slice of VolumeTypeAccess.
+func ExtractAccesses(r pagination.Page) ([]VolumeTypeAccess, error) {
+	var s struct {
+		VolumeTypeAccesses []VolumeTypeAccess `json:"volume_type_access"`
+	}
+	err := (r.(AccessPage)).ExtractInto(&s)
+	return s.VolumeTypeAccesses, err
+}
+
+// AddAccessResult is the response from a AddAccess request. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type AddAccessResult struct {
+	gophercloud.ErrResult
+}
+
+// RemoveAccessResult is the response from a RemoveAccess request. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type RemoveAccessResult struct {
+	gophercloud.ErrResult
+}
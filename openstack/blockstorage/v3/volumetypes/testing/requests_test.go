@@ -3,6 +3,7 @@ package testing
 import (
 	"testing"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -116,3 +117,121 @@ func TestUpdate(t *testing.T) {
 	th.CheckEquals(t, "vol-type-002", v.Name)
 	th.CheckEquals(t, true, v.IsPublic)
 }
+
+func TestVolumeTypeExtraSpecsList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExtraSpecsListSuccessfully(t)
+
+	expected := ExtraSpecs
+	actual, err := volumetypes.ListExtraSpecs(client.ServiceClient(), "1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestVolumeTypeExtraSpecGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExtraSpecGetSuccessfully(t)
+
+	expected := ExtraSpec
+	actual, err := volumetypes.GetExtraSpec(client.ServiceClient(), "1", "capabilities").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestVolumeTypeExtraSpecsCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExtraSpecsCreateSuccessfully(t)
+
+	createOpts := volumetypes.ExtraSpecsOpts{
+		"capabilities":        "gpu",
+		"volume_backend_name": "ssd",
+	}
+	expected := ExtraSpecs
+	actual, err := volumetypes.CreateExtraSpecs(client.ServiceClient(), "1", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestVolumeTypeExtraSpecUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExtraSpecUpdateSuccessfully(t)
+
+	updateOpts := volumetypes.ExtraSpecsOpts{
+		"capabilities": "gpu-2",
+	}
+	expected := UpdatedExtraSpec
+	actual, err := volumetypes.UpdateExtraSpec(client.ServiceClient(), "1", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestVolumeTypeExtraSpecDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExtraSpecDeleteSuccessfully(t)
+
+	res := volumetypes.DeleteExtraSpec(client.ServiceClient(), "1", "capabilities")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestVolumeTypeListAccesses(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAccessListSuccessfully(t)
+
+	expected := []volumetypes.VolumeTypeAccess{
+		{
+			VolumeTypeID: "1",
+			ProjectID:    "6f70656e737461636b20342065766572",
+		},
+	}
+
+	allPages, err := volumetypes.ListAccesses(client.ServiceClient(), "1").AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := volumetypes.ExtractAccesses(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestVolumeTypeAddAccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAccessActionSuccessfully(t, `{"addProjectAccess": {"project": "g8e3kwx7"}}`)
+
+	addAccessOpts := volumetypes.AddAccessOpts{
+		Project: "g8e3kwx7",
+	}
+
+	err := volumetypes.AddAccess(client.ServiceClient(), "1", addAccessOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestVolumeTypeAccessRequiredFields(t *testing.T) {
+	_, err := volumetypes.AddAccessOpts{}.ToVolumeTypeAddAccessMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput when Project is missing, got %#v", err)
+	}
+
+	_, err = volumetypes.RemoveAccessOpts{}.ToVolumeTypeRemoveAccessMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput when Project is missing, got %#v", err)
+	}
+}
+
+func TestVolumeTypeRemoveAccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAccessActionSuccessfully(t, `{"removeProjectAccess": {"project": "g8e3kwx7"}}`)
+
+	removeAccessOpts := volumetypes.RemoveAccessOpts{
+		Project: "g8e3kwx7",
+	}
+
+	err := volumetypes.RemoveAccess(client.ServiceClient(), "1", removeAccessOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -160,10 +160,12 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 				return err
 			}
 			client.TokenID = tac.TokenID
+			client.TokenExpiresAt = tac.TokenExpiresAt
 			return nil
 		}
 	}
 	client.TokenID = token.ID
+	client.TokenExpiresAt = tokenExpiry(client, token.ExpiresAt)
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return V2EndpointURL(catalog, opts)
 	}
@@ -187,7 +189,19 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.Au
 		v3Client.Endpoint = endpoint
 	}
 
-	result := tokens3.Create(v3Client, opts)
+	// Reuse a cached token if possible.
+	var result tokens3.CreateResult
+	var cacheKey string
+	var cached bool
+	if client.TokenCache != nil {
+		cacheKey, err = v3TokenCacheKey(v3Client, opts)
+		if err == nil {
+			result, cached = loadV3Token(client, v3Client, cacheKey)
+		}
+	}
+	if !cached {
+		result = tokens3.Create(v3Client, opts)
+	}
 
 	token, err := result.ExtractToken()
 	if err != nil {
@@ -199,7 +213,12 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.Au
 		return err
 	}
 
+	if cacheKey != "" && !cached {
+		storeV3Token(client, cacheKey, result, token)
+	}
+
 	client.TokenID = token.ID
+	client.TokenExpiresAt = tokenExpiry(client, token.ExpiresAt)
 
 	if opts.CanReauth() {
 		// here we're creating a throw-away client (tac). it's a copy of the user's provider client, but
@@ -227,6 +246,7 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.Au
 				return err
 			}
 			client.TokenID = tac.TokenID
+			client.TokenExpiresAt = tac.TokenExpiresAt
 			return nil
 		}
 	}
@@ -0,0 +1,47 @@
+/*
+Package clientconfig reads cloud settings from the clouds.yaml and secure.yaml
+files used by every OpenStack client, and turns them into the options needed
+to authenticate and locate service endpoints.
+
+Both files are searched for in the current directory, ~/.config/openstack and
+/etc/openstack, in that order. The OS_CLIENT_CONFIG_FILE and
+OS_CLIENT_SECURE_FILE environment variables may point to specific files
+instead. The settings of a cloud in secure.yaml override those in clouds.yaml,
+and a cloud may inherit the settings of a profile defined in
+clouds-public.yaml.
+
+Example to Create an Authenticated Client
+
+	// The cloud defaults to the value of OS_CLOUD.
+	opts := &clientconfig.ClientOpts{
+		Cloud: "mycloud",
+	}
+
+	provider, err := clientconfig.AuthenticatedClient(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	eo, err := clientconfig.EndpointOpts(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	computeClient, err := openstack.NewComputeV2(provider, eo)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Retrieve the Authentication Options of a Cloud
+
+	opts := &clientconfig.ClientOpts{
+		Cloud:      "mycloud",
+		RegionName: "RegionTwo",
+	}
+
+	authOptions, err := clientconfig.AuthOptions(opts)
+	if err != nil {
+		panic(err)
+	}
+*/
+package clientconfig
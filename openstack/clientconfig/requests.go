@@ -0,0 +1,381 @@
+package clientconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+)
+
+// ClientOpts represents options to select a cloud from the configuration
+// files.
+type ClientOpts struct {
+	// Cloud is the name of the cloud to use. It defaults to the value of the
+	// OS_CLOUD environment variable.
+	Cloud string
+
+	// RegionName overrides the region set in the configuration files. It
+	// defaults to the value of the OS_REGION_NAME environment variable.
+	RegionName string
+
+	// AuthInfo, if set, overrides the auth section of the selected cloud.
+	AuthInfo *AuthInfo
+}
+
+func (opts *ClientOpts) cloudName() string {
+	if opts != nil && opts.Cloud != "" {
+		return opts.Cloud
+	}
+	return os.Getenv("OS_CLOUD")
+}
+
+func (opts *ClientOpts) regionName() string {
+	if opts != nil && opts.RegionName != "" {
+		return opts.RegionName
+	}
+	return os.Getenv("OS_REGION_NAME")
+}
+
+// LoadCloudsYAML loads the clouds defined in the first clouds.yaml file found
+// in the current directory, ~/.config/openstack or /etc/openstack. The
+// OS_CLIENT_CONFIG_FILE environment variable may point to a different file.
+func LoadCloudsYAML() (map[string]Cloud, error) {
+	var clouds Clouds
+	_, err := loadFile(&clouds, "OS_CLIENT_CONFIG_FILE", "clouds.yaml", "clouds.yml")
+	return clouds.Clouds, err
+}
+
+// LoadSecureCloudsYAML loads the clouds defined in the first secure.yaml file
+// found in the same locations as clouds.yaml. The OS_CLIENT_SECURE_FILE
+// environment variable may point to a different file.
+func LoadSecureCloudsYAML() (map[string]Cloud, error) {
+	var clouds Clouds
+	_, err := loadFile(&clouds, "OS_CLIENT_SECURE_FILE", "secure.yaml", "secure.yml")
+	return clouds.Clouds, err
+}
+
+// LoadPublicCloudsYAML loads the cloud profiles defined in the first
+// clouds-public.yaml file found in the same locations as clouds.yaml.
+func LoadPublicCloudsYAML() (map[string]Cloud, error) {
+	var clouds PublicClouds
+	_, err := loadFile(&clouds, "OS_CLIENT_PUBLIC_FILE", "clouds-public.yaml", "clouds-public.yml")
+	return clouds.PublicClouds, err
+}
+
+// GetCloudFromYAML returns the cloud selected by opts, with the settings of
+// its clouds.yaml entry merged with those of its secure.yaml entry and the
+// profile it inherits from. If the cloud has a list of regions, the values of
+// the selected region are merged in as well, and RegionName is set.
+func GetCloudFromYAML(opts *ClientOpts) (*Cloud, error) {
+	name := opts.cloudName()
+	if name == "" {
+		return nil, gophercloud.ErrMissingEnvironmentVariable{EnvironmentVariable: "OS_CLOUD"}
+	}
+
+	clouds, err := LoadCloudsYAML()
+	if err != nil {
+		return nil, err
+	}
+	secureClouds, err := LoadSecureCloudsYAML()
+	if err != nil {
+		return nil, err
+	}
+
+	cloud, ok := clouds[name]
+	secureCloud, secureOK := secureClouds[name]
+	if !ok && !secureOK {
+		return nil, fmt.Errorf("cloud %s does not exist in clouds.yaml", name)
+	}
+
+	if secureOK {
+		cloud, err = mergeClouds(cloud, secureCloud)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	profileName := cloud.Profile
+	if profileName == "" {
+		profileName = cloud.Cloud
+	}
+	if profileName != "" {
+		profiles, err := LoadPublicCloudsYAML()
+		if err != nil {
+			return nil, err
+		}
+		profile, ok := profiles[profileName]
+		if !ok {
+			return nil, fmt.Errorf("cloud profile %s does not exist in clouds-public.yaml", profileName)
+		}
+		cloud, err = mergeClouds(profile, cloud)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	regionName := opts.regionName()
+	if regionName == "" {
+		regionName = cloud.RegionName
+	}
+	if regionName == "" && len(cloud.Regions) > 0 {
+		regionName = cloud.Regions[0].Name
+	}
+	for _, region := range cloud.Regions {
+		if region.Name == regionName {
+			cloud, err = mergeClouds(cloud, region.Values)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	cloud.RegionName = regionName
+
+	if opts != nil && opts.AuthInfo != nil {
+		cloud, err = mergeClouds(cloud, Cloud{AuthInfo: opts.AuthInfo})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &cloud, nil
+}
+
+// AuthOptions returns the gophercloud.AuthOptions of the cloud selected by
+// opts. If no cloud is selected, the options are read from the OS_*
+// environment variables with openstack.AuthOptionsFromEnv instead.
+func AuthOptions(opts *ClientOpts) (*gophercloud.AuthOptions, error) {
+	if opts.cloudName() == "" {
+		ao, err := openstack.AuthOptionsFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		return &ao, nil
+	}
+
+	cloud, err := GetCloudFromYAML(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudAuthOptions(cloud)
+}
+
+func cloudAuthOptions(cloud *Cloud) (*gophercloud.AuthOptions, error) {
+	auth := cloud.AuthInfo
+	if auth == nil || auth.AuthURL == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "auth_url"}
+	}
+
+	ao := &gophercloud.AuthOptions{
+		IdentityEndpoint: auth.AuthURL,
+		TokenID:          auth.Token,
+		Username:         auth.Username,
+		UserID:           auth.UserID,
+		Password:         auth.Password,
+		TenantID:         auth.ProjectID,
+		TenantName:       auth.ProjectName,
+
+		ApplicationCredentialID:     auth.ApplicationCredentialID,
+		ApplicationCredentialName:   auth.ApplicationCredentialName,
+		ApplicationCredentialSecret: auth.ApplicationCredentialSecret,
+	}
+
+	if identityVersion(cloud) == "2" {
+		return ao, nil
+	}
+
+	// Application credentials are already scoped to a project.
+	if auth.ApplicationCredentialID != "" {
+		return ao, nil
+	}
+
+	// Only usernames are qualified with a domain.
+	if auth.UserID == "" && auth.Token == "" {
+		ao.DomainID = firstNonEmpty(auth.UserDomainID, auth.DomainID)
+		ao.DomainName = firstNonEmpty(auth.UserDomainName, auth.DomainName)
+		if ao.DomainID == "" && ao.DomainName == "" {
+			ao.DomainID = auth.DefaultDomain
+		}
+	}
+
+	switch {
+	case auth.ProjectID != "":
+		ao.Scope = &gophercloud.AuthScope{ProjectID: auth.ProjectID}
+	case auth.ProjectName != "":
+		ao.Scope = &gophercloud.AuthScope{
+			ProjectName: auth.ProjectName,
+			DomainID:    firstNonEmpty(auth.ProjectDomainID, auth.DomainID),
+			DomainName:  firstNonEmpty(auth.ProjectDomainName, auth.DomainName),
+		}
+		if ao.Scope.DomainID == "" && ao.Scope.DomainName == "" {
+			ao.Scope.DomainID = auth.DefaultDomain
+		}
+	case auth.DomainID != "":
+		ao.Scope = &gophercloud.AuthScope{DomainID: auth.DomainID}
+	case auth.DomainName != "":
+		ao.Scope = &gophercloud.AuthScope{DomainName: auth.DomainName}
+	}
+
+	return ao, nil
+}
+
+func identityVersion(cloud *Cloud) string {
+	if v := strings.TrimPrefix(cloud.IdentityAPIVersion, "v"); v != "" {
+		return strings.Split(v, ".")[0]
+	}
+	if strings.Contains(cloud.AuthInfo.AuthURL, "v2.0") {
+		return "2"
+	}
+	return "3"
+}
+
+// EndpointOpts returns the gophercloud.EndpointOpts of the cloud selected by
+// opts, holding its region and interface. If no cloud is selected, the region
+// is read from the OS_REGION_NAME environment variable.
+func EndpointOpts(opts *ClientOpts) (gophercloud.EndpointOpts, error) {
+	if opts.cloudName() == "" {
+		return gophercloud.EndpointOpts{Region: opts.regionName()}, nil
+	}
+
+	cloud, err := GetCloudFromYAML(opts)
+	if err != nil {
+		return gophercloud.EndpointOpts{}, err
+	}
+
+	return cloudEndpointOpts(cloud)
+}
+
+func cloudEndpointOpts(cloud *Cloud) (gophercloud.EndpointOpts, error) {
+	eo := gophercloud.EndpointOpts{Region: cloud.RegionName}
+
+	iface := strings.TrimSuffix(firstNonEmpty(cloud.Interface, cloud.EndpointType), "URL")
+	switch iface {
+	case "":
+	case "public":
+		eo.Availability = gophercloud.AvailabilityPublic
+	case "internal":
+		eo.Availability = gophercloud.AvailabilityInternal
+	case "admin":
+		eo.Availability = gophercloud.AvailabilityAdmin
+	default:
+		return eo, gophercloud.ErrInvalidInput{
+			ErrMissingInput: gophercloud.ErrMissingInput{Argument: "interface"},
+			Value:           iface,
+		}
+	}
+
+	return eo, nil
+}
+
+// TLSConfig returns the TLS configuration described by the verify, cacert,
+// cert and key settings of the cloud. It returns nil if the cloud uses none
+// of them.
+func TLSConfig(cloud *Cloud) (*tls.Config, error) {
+	if cloud.Verify == nil && cloud.CACertFile == "" && cloud.ClientCertFile == "" && cloud.ClientKeyFile == "" {
+		return nil, nil
+	}
+
+	config := new(tls.Config)
+
+	if cloud.Verify != nil && !*cloud.Verify {
+		config.InsecureSkipVerify = true
+	}
+
+	if cloud.CACertFile != "" {
+		caCert, err := ioutil.ReadFile(cloud.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificate found in %s", cloud.CACertFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if cloud.ClientCertFile != "" || cloud.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cloud.ClientCertFile, cloud.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// NewProviderClient returns an unauthenticated ProviderClient for the cloud
+// selected by opts, whose HTTP client honours the cloud's TLS settings, along
+// with the cloud's AuthOptions.
+func NewProviderClient(opts *ClientOpts) (*gophercloud.ProviderClient, *gophercloud.AuthOptions, error) {
+	if opts.cloudName() == "" {
+		ao, err := AuthOptions(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		pc, err := openstack.NewClient(ao.IdentityEndpoint)
+		return pc, ao, err
+	}
+
+	cloud, err := GetCloudFromYAML(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ao, err := cloudAuthOptions(cloud)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pc, err := openstack.NewClient(ao.IdentityEndpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig, err := TLSConfig(cloud)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tlsConfig != nil {
+		pc.HTTPClient = http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		}
+	}
+
+	return pc, ao, nil
+}
+
+// AuthenticatedClient returns a ProviderClient authenticated against the
+// cloud selected by opts.
+func AuthenticatedClient(opts *ClientOpts) (*gophercloud.ProviderClient, error) {
+	pc, ao, err := NewProviderClient(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = openstack.Authenticate(pc, *ao)
+	if err != nil {
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,112 @@
+package clientconfig
+
+// Clouds represents a collection of Cloud entries in a clouds.yaml or
+// secure.yaml file.
+type Clouds struct {
+	Clouds map[string]Cloud `yaml:"clouds"`
+}
+
+// PublicClouds represents a collection of cloud profiles in a
+// clouds-public.yaml file. A Cloud inherits the settings of the profile it
+// names in its Profile field.
+type PublicClouds struct {
+	PublicClouds map[string]Cloud `yaml:"public-clouds"`
+}
+
+// Cloud represents an entry in a clouds.yaml, secure.yaml or
+// clouds-public.yaml file.
+type Cloud struct {
+	// Profile is the name of a clouds-public.yaml entry whose settings this
+	// cloud inherits. Cloud is the legacy name of the same setting.
+	Profile string `yaml:"profile,omitempty"`
+	Cloud   string `yaml:"cloud,omitempty"`
+
+	AuthInfo *AuthInfo `yaml:"auth,omitempty"`
+	AuthType string    `yaml:"auth_type,omitempty"`
+
+	// RegionName is the region to use. If it is not set, the first entry of
+	// Regions is used.
+	RegionName string   `yaml:"region_name,omitempty"`
+	Regions    []Region `yaml:"regions,omitempty"`
+
+	// Interface is the endpoint interface to use: public, internal or admin.
+	// EndpointType is the legacy name of the same setting.
+	Interface    string `yaml:"interface,omitempty"`
+	EndpointType string `yaml:"endpoint_type,omitempty"`
+
+	// IdentityAPIVersion is the Identity API version to use: 2 or 3.
+	IdentityAPIVersion string `yaml:"identity_api_version,omitempty"`
+
+	// Verify controls whether the server's TLS certificate is verified. It
+	// defaults to true.
+	Verify *bool `yaml:"verify,omitempty"`
+
+	// CACertFile is a path to a CA bundle used to verify the server's
+	// certificate.
+	CACertFile string `yaml:"cacert,omitempty"`
+
+	// ClientCertFile and ClientKeyFile are paths to a client certificate and
+	// its key, used for mutual TLS.
+	ClientCertFile string `yaml:"cert,omitempty"`
+	ClientKeyFile  string `yaml:"key,omitempty"`
+}
+
+// AuthInfo represents the auth section of a Cloud.
+type AuthInfo struct {
+	AuthURL string `yaml:"auth_url,omitempty"`
+	Token   string `yaml:"token,omitempty"`
+
+	Username string `yaml:"username,omitempty"`
+	UserID   string `yaml:"user_id,omitempty"`
+	Password string `yaml:"password,omitempty"`
+
+	ProjectName string `yaml:"project_name,omitempty"`
+	ProjectID   string `yaml:"project_id,omitempty"`
+
+	UserDomainName    string `yaml:"user_domain_name,omitempty"`
+	UserDomainID      string `yaml:"user_domain_id,omitempty"`
+	ProjectDomainName string `yaml:"project_domain_name,omitempty"`
+	ProjectDomainID   string `yaml:"project_domain_id,omitempty"`
+
+	// DomainName and DomainID are used as the user and project domain when
+	// those are not set, or to request a domain-scoped token when no project
+	// is set.
+	DomainName string `yaml:"domain_name,omitempty"`
+	DomainID   string `yaml:"domain_id,omitempty"`
+
+	// DefaultDomain is used as the user and project domain ID when no other
+	// domain setting applies.
+	DefaultDomain string `yaml:"default_domain,omitempty"`
+
+	// ApplicationCredentialID, or ApplicationCredentialName along with a
+	// user, authenticate with an application credential and its secret
+	// instead of a password.
+	ApplicationCredentialID     string `yaml:"application_credential_id,omitempty"`
+	ApplicationCredentialName   string `yaml:"application_credential_name,omitempty"`
+	ApplicationCredentialSecret string `yaml:"application_credential_secret,omitempty"`
+}
+
+// Region represents an entry of a Cloud's regions list. An entry is either a
+// plain region name, or a name with a set of values which override the
+// Cloud's settings in that region.
+type Region struct {
+	Name   string `yaml:"name"`
+	Values Cloud  `yaml:"values,omitempty"`
+}
+
+// UnmarshalYAML allows a Region to be given as a plain string.
+func (r *Region) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err == nil {
+		r.Name = name
+		return nil
+	}
+
+	type region Region
+	var s region
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	*r = Region(s)
+	return nil
+}
@@ -0,0 +1,2 @@
+// clientconfig unit tests
+package testing
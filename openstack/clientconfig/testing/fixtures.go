@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const CloudsYAML = `
+clouds:
+  california:
+    auth:
+      auth_url: https://identity.example.com:5000/v3
+      username: jdoe
+      project_name: Some Project
+      user_domain_name: default
+      project_domain_name: default
+    region_name: SAN_FRANCISCO
+    interface: internal
+  arizona:
+    profile: rackspace
+    auth:
+      username: jdoe
+      project_id: 0f7d7ae1a9ee4bdc9b8b9f1a0a5e6d34
+    regions:
+      - PHOENIX
+      - name: TUCSON
+        values:
+          interface: admin
+  nevada:
+    auth:
+      auth_url: https://identity.example.com:5000/v2.0
+      username: jdoe
+      password: password
+      project_name: Some Project
+    verify: false
+  texas:
+    auth_type: v3applicationcredential
+    auth:
+      auth_url: https://identity.example.com:5000/v3
+      application_credential_id: 12345abcdef
+      application_credential_secret: mysecret
+`
+
+const SecureYAML = `
+clouds:
+  california:
+    auth:
+      password: secret
+  arizona:
+    auth:
+      password: other-secret
+`
+
+const PublicCloudsYAML = `
+public-clouds:
+  rackspace:
+    auth:
+      auth_url: https://identity.rackspace.example.com/v3
+      user_domain_id: rs
+      project_domain_id: rs
+    interface: public
+`
+
+// SetupConfigFiles writes the fixture configuration files to a temporary
+// directory and points the OS_CLIENT_*_FILE environment variables at them.
+// The returned function restores the environment.
+func SetupConfigFiles(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "clientconfig")
+	th.AssertNoErr(t, err)
+
+	files := map[string]string{
+		"OS_CLIENT_CONFIG_FILE": CloudsYAML,
+		"OS_CLIENT_SECURE_FILE": SecureYAML,
+		"OS_CLIENT_PUBLIC_FILE": PublicCloudsYAML,
+	}
+
+	vars := []string{"OS_CLOUD", "OS_REGION_NAME"}
+	for envVar, content := range files {
+		path := filepath.Join(dir, envVar+".yaml")
+		th.AssertNoErr(t, ioutil.WriteFile(path, []byte(content), 0600))
+		vars = append(vars, envVar)
+	}
+
+	saved := make(map[string]string)
+	for _, envVar := range vars {
+		saved[envVar] = os.Getenv(envVar)
+		os.Unsetenv(envVar)
+	}
+	for envVar := range files {
+		os.Setenv(envVar, filepath.Join(dir, envVar+".yaml"))
+	}
+
+	return func() {
+		for envVar, v := range saved {
+			os.Setenv(envVar, v)
+		}
+		os.RemoveAll(dir)
+	}
+}
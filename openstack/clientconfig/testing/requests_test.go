@@ -0,0 +1,179 @@
+package testing
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/clientconfig"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestGetCloudFromYAML(t *testing.T) {
+	defer SetupConfigFiles(t)()
+
+	cloud, err := clientconfig.GetCloudFromYAML(&clientconfig.ClientOpts{Cloud: "california"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "secret", cloud.AuthInfo.Password)
+	th.AssertEquals(t, "jdoe", cloud.AuthInfo.Username)
+	th.AssertEquals(t, "SAN_FRANCISCO", cloud.RegionName)
+
+	_, err = clientconfig.GetCloudFromYAML(&clientconfig.ClientOpts{Cloud: "oregon"})
+	if err == nil {
+		t.Fatal("Expected an error for an unknown cloud")
+	}
+}
+
+func TestGetCloudFromYAMLProfileAndRegions(t *testing.T) {
+	defer SetupConfigFiles(t)()
+
+	os.Setenv("OS_CLOUD", "arizona")
+	cloud, err := clientconfig.GetCloudFromYAML(nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "https://identity.rackspace.example.com/v3", cloud.AuthInfo.AuthURL)
+	th.AssertEquals(t, "rs", cloud.AuthInfo.UserDomainID)
+	th.AssertEquals(t, "other-secret", cloud.AuthInfo.Password)
+	th.AssertEquals(t, "PHOENIX", cloud.RegionName)
+	th.AssertEquals(t, "public", cloud.Interface)
+
+	cloud, err = clientconfig.GetCloudFromYAML(&clientconfig.ClientOpts{RegionName: "TUCSON"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "TUCSON", cloud.RegionName)
+	th.AssertEquals(t, "admin", cloud.Interface)
+}
+
+func TestAuthOptionsV3(t *testing.T) {
+	defer SetupConfigFiles(t)()
+
+	ao, err := clientconfig.AuthOptions(&clientconfig.ClientOpts{Cloud: "california"})
+	th.AssertNoErr(t, err)
+
+	expected := &gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com:5000/v3",
+		Username:         "jdoe",
+		Password:         "secret",
+		TenantName:       "Some Project",
+		DomainName:       "default",
+		Scope: &gophercloud.AuthScope{
+			ProjectName: "Some Project",
+			DomainName:  "default",
+		},
+	}
+	th.AssertDeepEquals(t, expected, ao)
+
+	ao, err = clientconfig.AuthOptions(&clientconfig.ClientOpts{Cloud: "arizona"})
+	th.AssertNoErr(t, err)
+
+	expected = &gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.rackspace.example.com/v3",
+		Username:         "jdoe",
+		Password:         "other-secret",
+		TenantID:         "0f7d7ae1a9ee4bdc9b8b9f1a0a5e6d34",
+		DomainID:         "rs",
+		Scope: &gophercloud.AuthScope{
+			ProjectID: "0f7d7ae1a9ee4bdc9b8b9f1a0a5e6d34",
+		},
+	}
+	th.AssertDeepEquals(t, expected, ao)
+}
+
+func TestAuthOptionsV2(t *testing.T) {
+	defer SetupConfigFiles(t)()
+
+	ao, err := clientconfig.AuthOptions(&clientconfig.ClientOpts{Cloud: "nevada"})
+	th.AssertNoErr(t, err)
+
+	expected := &gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com:5000/v2.0",
+		Username:         "jdoe",
+		Password:         "password",
+		TenantName:       "Some Project",
+	}
+	th.AssertDeepEquals(t, expected, ao)
+}
+
+func TestAuthOptionsApplicationCredential(t *testing.T) {
+	defer SetupConfigFiles(t)()
+
+	ao, err := clientconfig.AuthOptions(&clientconfig.ClientOpts{Cloud: "texas"})
+	th.AssertNoErr(t, err)
+
+	expected := &gophercloud.AuthOptions{
+		IdentityEndpoint:            "https://identity.example.com:5000/v3",
+		ApplicationCredentialID:     "12345abcdef",
+		ApplicationCredentialSecret: "mysecret",
+	}
+	th.AssertDeepEquals(t, expected, ao)
+}
+
+func TestAuthOptionsFromEnv(t *testing.T) {
+	defer SetupConfigFiles(t)()
+
+	os.Setenv("OS_AUTH_URL", "https://identity.example.com:5000/v3")
+	os.Setenv("OS_USERNAME", "envuser")
+	os.Setenv("OS_PASSWORD", "envpass")
+	defer os.Unsetenv("OS_AUTH_URL")
+	defer os.Unsetenv("OS_USERNAME")
+	defer os.Unsetenv("OS_PASSWORD")
+
+	ao, err := clientconfig.AuthOptions(nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "envuser", ao.Username)
+	th.AssertEquals(t, "envpass", ao.Password)
+}
+
+func TestEndpointOpts(t *testing.T) {
+	defer SetupConfigFiles(t)()
+
+	eo, err := clientconfig.EndpointOpts(&clientconfig.ClientOpts{Cloud: "california"})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.EndpointOpts{
+		Region:       "SAN_FRANCISCO",
+		Availability: gophercloud.AvailabilityInternal,
+	}, eo)
+
+	eo, err = clientconfig.EndpointOpts(&clientconfig.ClientOpts{Cloud: "arizona", RegionName: "TUCSON"})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.EndpointOpts{
+		Region:       "TUCSON",
+		Availability: gophercloud.AvailabilityAdmin,
+	}, eo)
+}
+
+func TestTLSConfig(t *testing.T) {
+	defer SetupConfigFiles(t)()
+
+	cloud, err := clientconfig.GetCloudFromYAML(&clientconfig.ClientOpts{Cloud: "nevada"})
+	th.AssertNoErr(t, err)
+
+	config, err := clientconfig.TLSConfig(cloud)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, config.InsecureSkipVerify)
+
+	cloud, err = clientconfig.GetCloudFromYAML(&clientconfig.ClientOpts{Cloud: "california"})
+	th.AssertNoErr(t, err)
+
+	config, err = clientconfig.TLSConfig(cloud)
+	th.AssertNoErr(t, err)
+	if config != nil {
+		t.Fatalf("Expected no TLS configuration, got %#v", config)
+	}
+
+	cloud.CACertFile = "/nonexistent/ca.pem"
+	_, err = clientconfig.TLSConfig(cloud)
+	if err == nil {
+		t.Fatal("Expected an error for a missing CA bundle")
+	}
+}
+
+func TestNewProviderClient(t *testing.T) {
+	defer SetupConfigFiles(t)()
+
+	pc, ao, err := clientconfig.NewProviderClient(&clientconfig.ClientOpts{Cloud: "nevada"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "https://identity.example.com:5000/v2.0/", pc.IdentityEndpoint)
+	th.AssertEquals(t, "jdoe", ao.Username)
+	if pc.HTTPClient.Transport == nil {
+		t.Fatal("Expected a TLS-configured transport")
+	}
+}
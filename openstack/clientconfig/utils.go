@@ -0,0 +1,132 @@
+package clientconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// configDirs returns the directories searched for configuration files, in
+// order of precedence: the current directory, the user's configuration
+// directory, and the system-wide configuration directory.
+func configDirs() []string {
+	dirs := []string{"."}
+
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		dirs = append(dirs, filepath.Join(xdg, "openstack"))
+	} else if home := homeDir(); home != "" {
+		dirs = append(dirs, filepath.Join(home, ".config", "openstack"))
+	}
+
+	return append(dirs, "/etc/openstack")
+}
+
+func homeDir() string {
+	if home := os.Getenv("HOME"); home != "" {
+		return home
+	}
+	if u, err := user.Current(); err == nil {
+		return u.HomeDir
+	}
+	return ""
+}
+
+// findFile returns the path of the first existing file among the given base
+// names in the standard configuration directories. If envVar is set, its value
+// is used instead of searching. An empty path is returned if no file exists.
+func findFile(envVar string, names ...string) (string, error) {
+	if v := os.Getenv(envVar); v != "" {
+		if _, err := os.Stat(v); err != nil {
+			return "", err
+		}
+		return v, nil
+	}
+
+	for _, dir := range configDirs() {
+		for _, name := range names {
+			path := filepath.Join(dir, name)
+			if _, err := os.Stat(path); err == nil {
+				return path, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// loadFile finds and decodes a configuration file into v. It returns false if
+// no file was found.
+func loadFile(v interface{}, envVar string, names ...string) (bool, error) {
+	path, err := findFile(envVar, names...)
+	if err != nil || path == "" {
+		return false, err
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	if err := yaml.Unmarshal(content, v); err != nil {
+		return false, fmt.Errorf("failed to parse %s: %s", path, err)
+	}
+
+	return true, nil
+}
+
+// mergeClouds returns a Cloud holding the settings of base, overridden by the
+// settings which are set in override. Maps are merged recursively, all other
+// values are replaced.
+func mergeClouds(base, override Cloud) (Cloud, error) {
+	baseMap, err := toMap(base)
+	if err != nil {
+		return Cloud{}, err
+	}
+	overrideMap, err := toMap(override)
+	if err != nil {
+		return Cloud{}, err
+	}
+
+	b, err := yaml.Marshal(mergeMaps(baseMap, overrideMap))
+	if err != nil {
+		return Cloud{}, err
+	}
+
+	var merged Cloud
+	err = yaml.Unmarshal(b, &merged)
+	return merged, err
+}
+
+func toMap(c Cloud) (map[interface{}]interface{}, error) {
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[interface{}]interface{})
+	err = yaml.Unmarshal(b, &m)
+	return m, err
+}
+
+func mergeMaps(base, override map[interface{}]interface{}) map[interface{}]interface{} {
+	merged := make(map[interface{}]interface{}, len(base))
+	for k, v := range base {
+		merged[k] = v
+	}
+
+	for k, v := range override {
+		bv, ok := merged[k].(map[interface{}]interface{})
+		ov, isMap := v.(map[interface{}]interface{})
+		if ok && isMap {
+			merged[k] = mergeMaps(bv, ov)
+			continue
+		}
+		merged[k] = v
+	}
+
+	return merged
+}
@@ -0,0 +1,24 @@
+package clusters
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// NewWaiter returns a Waiter which polls a cluster until its status is one of
+// target. A cluster in ERROR or CRITICAL is a failure. The Wait method of the
+// Waiter returns the cluster as a *Cluster.
+func NewWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.Waiter {
+	return &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			cluster, err := Get(c.WithContext(ctx), id).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return cluster, cluster.Status, nil
+		},
+		Target:  target,
+		Failure: []string{"ERROR", "CRITICAL"},
+	}
+}
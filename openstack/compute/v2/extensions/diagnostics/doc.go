@@ -0,0 +1,23 @@
+/*
+Package diagnostics returns the diagnostics of a server, such as the usage of
+its CPUs, network interfaces, disks and memory.
+
+The diagnostics follow a standard format with microversion 2.48 or later.
+With older microversions, their format depends on the hypervisor; use
+ExtractMap to read them.
+
+Example to Get the Diagnostics of a Server
+
+	computeClient.Microversion = "2.48"
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+	diags, err := diagnostics.Get(computeClient, serverID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, nic := range diags.NICDetails {
+		fmt.Printf("%s: %d bytes received\n", nic.MACAddress, nic.RxOctets)
+	}
+*/
+package diagnostics
@@ -0,0 +1,11 @@
+package diagnostics
+
+import "github.com/gophercloud/gophercloud"
+
+// Get retrieves the diagnostics of a server.
+func Get(client *gophercloud.ServiceClient, serverID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, serverID), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,114 @@
+package diagnostics
+
+import "github.com/gophercloud/gophercloud"
+
+// Diagnostics represents the diagnostics of a server, in the standard format
+// returned with microversion 2.48 or later.
+type Diagnostics struct {
+	// State is the current state of the server, such as "running".
+	State string `json:"state"`
+
+	// Driver is the name of the virtualization driver, such as "libvirt".
+	Driver string `json:"driver"`
+
+	// Hypervisor is the type of the hypervisor, such as "kvm".
+	Hypervisor string `json:"hypervisor"`
+
+	// HypervisorOS is the operating system of the hypervisor.
+	HypervisorOS string `json:"hypervisor_os"`
+
+	// Uptime is the amount of time in seconds that the server has been running.
+	Uptime int `json:"uptime"`
+
+	// ConfigDrive indicates whether the server uses a config drive.
+	ConfigDrive bool `json:"config_drive"`
+
+	// NumCPUs is the number of vCPUs of the server.
+	NumCPUs int `json:"num_cpus"`
+
+	// NumNICs is the number of network interfaces of the server.
+	NumNICs int `json:"num_nics"`
+
+	// NumDisks is the number of disks of the server.
+	NumDisks int `json:"num_disks"`
+
+	// CPUDetails holds the details of each vCPU.
+	CPUDetails []CPUDetail `json:"cpu_details"`
+
+	// NICDetails holds the details of each network interface.
+	NICDetails []NICDetail `json:"nic_details"`
+
+	// DiskDetails holds the details of each disk.
+	DiskDetails []DiskDetail `json:"disk_details"`
+
+	// MemoryDetails holds the details of the memory of the server.
+	MemoryDetails MemoryDetails `json:"memory_details"`
+}
+
+// CPUDetail holds the details of a vCPU of a server.
+type CPUDetail struct {
+	// ID is the index of the vCPU.
+	ID int `json:"id"`
+
+	// Time is the CPU time in nanoseconds.
+	Time int64 `json:"time"`
+
+	// Utilisation is the utilisation of the vCPU in percent.
+	Utilisation int `json:"utilisation"`
+}
+
+// NICDetail holds the details of a network interface of a server.
+type NICDetail struct {
+	MACAddress string `json:"mac_address"`
+	RxOctets   int64  `json:"rx_octets"`
+	RxErrors   int64  `json:"rx_errors"`
+	RxDrop     int64  `json:"rx_drop"`
+	RxPackets  int64  `json:"rx_packets"`
+	RxRate     int64  `json:"rx_rate"`
+	TxOctets   int64  `json:"tx_octets"`
+	TxErrors   int64  `json:"tx_errors"`
+	TxDrop     int64  `json:"tx_drop"`
+	TxPackets  int64  `json:"tx_packets"`
+	TxRate     int64  `json:"tx_rate"`
+}
+
+// DiskDetail holds the details of a disk of a server.
+type DiskDetail struct {
+	ReadBytes     int64 `json:"read_bytes"`
+	ReadRequests  int64 `json:"read_requests"`
+	WriteBytes    int64 `json:"write_bytes"`
+	WriteRequests int64 `json:"write_requests"`
+	ErrorsCount   int64 `json:"errors_count"`
+}
+
+// MemoryDetails holds the details of the memory of a server.
+type MemoryDetails struct {
+	// Maximum is the amount of memory of the server in MB.
+	Maximum int64 `json:"maximum"`
+
+	// Used is the amount of memory used by the server in MB.
+	Used int64 `json:"used"`
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as Diagnostics, or its ExtractMap method with microversions
+// older than 2.48.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as Diagnostics. The diagnostics only follow
+// this format with microversion 2.48 or later.
+func (r GetResult) Extract() (*Diagnostics, error) {
+	var s Diagnostics
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractMap interprets a GetResult as a map. Before microversion 2.48, the
+// keys of the map depend on the hypervisor of the server.
+func (r GetResult) ExtractMap() (map[string]interface{}, error) {
+	var s map[string]interface{}
+	err := r.ExtractInto(&s)
+	return s, err
+}
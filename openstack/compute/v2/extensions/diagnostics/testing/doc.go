@@ -0,0 +1,2 @@
+// diagnostics unit tests
+package testing
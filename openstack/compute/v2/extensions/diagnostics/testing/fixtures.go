@@ -0,0 +1,132 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/diagnostics"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// GetOutput is a sample response to a Get request.
+const GetOutput = `
+{
+    "config_drive": true,
+    "cpu_details": [
+        {
+            "id": 0,
+            "time": 17300000000,
+            "utilisation": 15
+        }
+    ],
+    "disk_details": [
+        {
+            "errors_count": 1,
+            "read_bytes": 262144,
+            "read_requests": 112,
+            "write_bytes": 5778432,
+            "write_requests": 488
+        }
+    ],
+    "driver": "libvirt",
+    "hypervisor": "kvm",
+    "hypervisor_os": "ubuntu",
+    "memory_details": {
+        "maximum": 524288,
+        "used": 0
+    },
+    "nic_details": [
+        {
+            "mac_address": "01:23:45:67:89:ab",
+            "rx_drop": 200,
+            "rx_errors": 100,
+            "rx_octets": 2070139,
+            "rx_packets": 26701,
+            "rx_rate": 300,
+            "tx_drop": 500,
+            "tx_errors": 400,
+            "tx_octets": 140208,
+            "tx_packets": 662,
+            "tx_rate": 600
+        }
+    ],
+    "num_cpus": 1,
+    "num_disks": 1,
+    "num_nics": 1,
+    "state": "running",
+    "uptime": 46664
+}
+`
+
+// LegacyGetOutput is a sample response to a Get request with a microversion
+// older than 2.48.
+const LegacyGetOutput = `
+{
+    "cpu0_time": 17300000000,
+    "memory": 524288,
+    "vda_errors": -1,
+    "vda_read": 262144,
+    "vda_read_req": 112,
+    "vda_write": 5778432,
+    "vda_write_req": 488
+}
+`
+
+// ExpectedDiagnostics is the diagnostics in GetOutput.
+var ExpectedDiagnostics = diagnostics.Diagnostics{
+	State:        "running",
+	Driver:       "libvirt",
+	Hypervisor:   "kvm",
+	HypervisorOS: "ubuntu",
+	Uptime:       46664,
+	ConfigDrive:  true,
+	NumCPUs:      1,
+	NumNICs:      1,
+	NumDisks:     1,
+	CPUDetails: []diagnostics.CPUDetail{
+		{ID: 0, Time: 17300000000, Utilisation: 15},
+	},
+	NICDetails: []diagnostics.NICDetail{
+		{
+			MACAddress: "01:23:45:67:89:ab",
+			RxOctets:   2070139,
+			RxErrors:   100,
+			RxDrop:     200,
+			RxPackets:  26701,
+			RxRate:     300,
+			TxOctets:   140208,
+			TxErrors:   400,
+			TxDrop:     500,
+			TxPackets:  662,
+			TxRate:     600,
+		},
+	},
+	DiskDetails: []diagnostics.DiskDetail{
+		{
+			ReadBytes:     262144,
+			ReadRequests:  112,
+			WriteBytes:    5778432,
+			WriteRequests: 488,
+			ErrorsCount:   1,
+		},
+	},
+	MemoryDetails: diagnostics.MemoryDetails{
+		Maximum: 524288,
+		Used:    0,
+	},
+}
+
+// HandleDiagnosticGetSuccessfully sets up the test server to respond to a
+// Get request with the given body.
+func HandleDiagnosticGetSuccessfully(t *testing.T, output string) {
+	th.Mux.HandleFunc("/servers/1234asdf/diagnostics", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, output)
+	})
+}
@@ -0,0 +1,32 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/diagnostics"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestGetDiagnostics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDiagnosticGetSuccessfully(t, GetOutput)
+
+	actual, err := diagnostics.Get(client.ServiceClient(), "1234asdf").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedDiagnostics, *actual)
+}
+
+func TestGetLegacyDiagnostics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDiagnosticGetSuccessfully(t, LegacyGetOutput)
+
+	actual, err := diagnostics.Get(client.ServiceClient(), "1234asdf").ExtractMap()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, float64(17300000000), actual["cpu0_time"])
+	th.CheckEquals(t, float64(262144), actual["vda_read"])
+}
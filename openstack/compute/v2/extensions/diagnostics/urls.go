@@ -0,0 +1,7 @@
+package diagnostics
+
+import "github.com/gophercloud/gophercloud"
+
+func getURL(client *gophercloud.ServiceClient, serverID string) string {
+	return client.ServiceURL("servers", serverID, "diagnostics")
+}
@@ -24,5 +24,16 @@ the extended status information. Example:
 		fmt.Println(server.VmState)
 		fmt.Println(server.PowerState)
 	}
+
+The VmState and TaskState of a server being shelved or unshelved by the
+shelveunshelve package can be compared to the constants of this package:
+
+	if server.VmState == extendedstatus.VmStateShelvedOffloaded {
+		fmt.Println("server is shelved offloaded")
+	}
+
+	if server.IsShelving() {
+		fmt.Printf("server is %s\n", server.TaskState)
+	}
 */
 package extendedstatus
@@ -39,3 +39,46 @@ func (r PowerState) String() string {
 		return "N/A"
 	}
 }
+
+// VM states reported in ServerExtendedStatusExt.VmState.
+const (
+	VmStateActive           = "active"
+	VmStateBuilding         = "building"
+	VmStatePaused           = "paused"
+	VmStateSuspended        = "suspended"
+	VmStateStopped          = "stopped"
+	VmStateRescued          = "rescued"
+	VmStateResized          = "resized"
+	VmStateSoftDeleted      = "soft-delete"
+	VmStateDeleted          = "deleted"
+	VmStateError            = "error"
+	VmStateShelved          = "shelved"
+	VmStateShelvedOffloaded = "shelved_offloaded"
+)
+
+// Task states reported in ServerExtendedStatusExt.TaskState while a server
+// is being shelved, shelve-offloaded or unshelved.
+const (
+	TaskStateShelving                   = "shelving"
+	TaskStateShelvingImagePendingUpload = "shelving_image_pending_upload"
+	TaskStateShelvingImageUploading     = "shelving_image_uploading"
+	TaskStateShelvingOffloading         = "shelving_offloading"
+	TaskStateUnshelving                 = "unshelving"
+	TaskStateSpawning                   = "spawning"
+)
+
+// IsShelved reports whether the server is shelved or shelve-offloaded.
+func (r ServerExtendedStatusExt) IsShelved() bool {
+	return r.VmState == VmStateShelved || r.VmState == VmStateShelvedOffloaded
+}
+
+// IsShelving reports whether the server is being shelved, shelve-offloaded
+// or unshelved.
+func (r ServerExtendedStatusExt) IsShelving() bool {
+	switch r.TaskState {
+	case TaskStateShelving, TaskStateShelvingImagePendingUpload, TaskStateShelvingImageUploading,
+		TaskStateShelvingOffloading, TaskStateUnshelving:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+/*
+Package instanceactions provides the ability to list and get the actions
+performed on a server, such as its creation, a reboot or a resize, along with
+the events each action is made of.
+
+Example to List a Server's Actions
+
+	serverID := "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f"
+	allPages, err := instanceactions.List(computeClient, serverID, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allActions, err := instanceactions.ExtractInstanceActions(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, action := range allActions {
+		fmt.Printf("%+v\n", action)
+	}
+
+Example to List a Server's Actions of the Last Day
+
+	changesSince := time.Now().Add(-24 * time.Hour)
+	listOpts := instanceactions.ListOpts{
+		ChangesSince: &changesSince,
+	}
+
+	computeClient.Microversion = "2.58"
+	allPages, err := instanceactions.List(computeClient, serverID, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get an Action and its Events
+
+	requestID := "req-3293a3f1-b44c-4609-b8d2-d81b105636b8"
+	action, err := instanceactions.Get(computeClient, serverID, requestID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, event := range action.Events {
+		fmt.Printf("%s: %s\n", event.Event, event.Result)
+		if event.Traceback != "" {
+			fmt.Println(event.Traceback)
+		}
+	}
+*/
+package instanceactions
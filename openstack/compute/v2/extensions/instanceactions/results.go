@@ -0,0 +1,186 @@
+package instanceactions
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// InstanceAction represents an action performed on a server, such as a
+// reboot or a resize.
+type InstanceAction struct {
+	// Action is the name of the action.
+	Action string `json:"action"`
+
+	// InstanceUUID is the UUID of the server.
+	InstanceUUID string `json:"instance_uuid"`
+
+	// Message is the related error message for when an action fails.
+	Message string `json:"message"`
+
+	// ProjectID is the ID of the project which initiated the action.
+	ProjectID string `json:"project_id"`
+
+	// RequestID is the ID of the request which generated the action.
+	RequestID string `json:"request_id"`
+
+	// StartTime is the time the action started.
+	StartTime time.Time `json:"-"`
+
+	// UpdatedAt is the time the action was last updated. This requires
+	// microversion 2.58 or later.
+	UpdatedAt time.Time `json:"-"`
+
+	// UserID is the ID of the user which initiated the action.
+	UserID string `json:"user_id"`
+}
+
+// UnmarshalJSON converts our JSON API response into our instance action struct.
+func (r *InstanceAction) UnmarshalJSON(b []byte) error {
+	type tmp InstanceAction
+	var s struct {
+		tmp
+		StartTime gophercloud.JSONRFC3339MilliNoZ `json:"start_time"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*r = InstanceAction(s.tmp)
+
+	r.StartTime = time.Time(s.StartTime)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// Event represents a step of an instance action.
+type Event struct {
+	// Event is the name of the event.
+	Event string `json:"event"`
+
+	// StartTime is the time the event started.
+	StartTime time.Time `json:"-"`
+
+	// FinishTime is the time the event finished.
+	FinishTime time.Time `json:"-"`
+
+	// Result is the result of the event, such as "Success" or "Error".
+	Result string `json:"result"`
+
+	// Traceback is the traceback of the event if it failed. It is only
+	// visible to administrators by default.
+	Traceback string `json:"traceback"`
+
+	// Host is the name of the host the event occurred on. It is only visible
+	// to administrators by default. This requires microversion 2.62 or later.
+	Host string `json:"host"`
+
+	// HostID is an obfuscated hashed host ID string. This requires
+	// microversion 2.62 or later.
+	HostID string `json:"hostId"`
+
+	// Details describes the event if it failed. This requires microversion
+	// 2.84 or later.
+	Details string `json:"details"`
+}
+
+// UnmarshalJSON converts our JSON API response into our event struct.
+func (r *Event) UnmarshalJSON(b []byte) error {
+	type tmp Event
+	var s struct {
+		tmp
+		StartTime  gophercloud.JSONRFC3339MilliNoZ `json:"start_time"`
+		FinishTime gophercloud.JSONRFC3339MilliNoZ `json:"finish_time"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*r = Event(s.tmp)
+
+	r.StartTime = time.Time(s.StartTime)
+	r.FinishTime = time.Time(s.FinishTime)
+
+	return nil
+}
+
+// InstanceActionDetail represents an instance action along with the events
+// it is made of.
+type InstanceActionDetail struct {
+	InstanceAction
+
+	// Events are the steps of the action. Only administrators may see them
+	// by default.
+	Events []Event `json:"events"`
+}
+
+// UnmarshalJSON converts our JSON API response into our instance action
+// detail struct. It is needed as the UnmarshalJSON method of the embedded
+// InstanceAction would otherwise hide the events.
+func (r *InstanceActionDetail) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &r.InstanceAction); err != nil {
+		return err
+	}
+
+	var s struct {
+		Events []Event `json:"events"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	r.Events = s.Events
+
+	return nil
+}
+
+// InstanceActionPage abstracts the raw results of making a List() request
+// against the API.
+type InstanceActionPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if an InstanceActionPage contains no instance actions.
+func (r InstanceActionPage) IsEmpty() (bool, error) {
+	actions, err := ExtractInstanceActions(r)
+	return len(actions) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r InstanceActionPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractInstanceActions interprets the results of a single page from a
+// List() call, producing a slice of InstanceAction entities.
+func ExtractInstanceActions(r pagination.Page) ([]InstanceAction, error) {
+	var s struct {
+		InstanceActions []InstanceAction `json:"instanceActions"`
+	}
+	err := (r.(InstanceActionPage)).ExtractInto(&s)
+	return s.InstanceActions, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as an InstanceActionDetail.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as an InstanceActionDetail.
+func (r GetResult) Extract() (*InstanceActionDetail, error) {
+	var s struct {
+		InstanceAction *InstanceActionDetail `json:"instanceAction"`
+	}
+	err := r.ExtractInto(&s)
+	return s.InstanceAction, err
+}
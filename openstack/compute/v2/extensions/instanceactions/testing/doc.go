@@ -0,0 +1,2 @@
+// instanceactions unit tests
+package testing
@@ -0,0 +1,168 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/instanceactions"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f"
+
+// ListOutput is a sample response to a List request.
+const ListOutput = `
+{
+    "instanceActions": [
+        {
+            "action": "stop",
+            "instance_uuid": "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f",
+            "message": null,
+            "project_id": "6f70656e737461636b20342065766572",
+            "request_id": "req-f8a59f03-76dc-412f-92c2-21f8612be728",
+            "start_time": "2018-04-25T01:26:29.092607",
+            "updated_at": "2018-04-25T01:26:29.372164",
+            "user_id": "admin"
+        },
+        {
+            "action": "create",
+            "instance_uuid": "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f",
+            "message": null,
+            "project_id": "6f70656e737461636b20342065766572",
+            "request_id": "req-50189019-626d-47fb-b944-b8342af09679",
+            "start_time": "2018-04-25T01:26:25.000000",
+            "updated_at": "2018-04-25T01:26:27.000000",
+            "user_id": "admin"
+        }
+    ],
+    "links": [
+        {
+            "href": "%s",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// EmptyListOutput is a sample response to a List request past the last page.
+const EmptyListOutput = `
+{
+    "instanceActions": []
+}
+`
+
+// GetOutput is a sample response to a Get request.
+const GetOutput = `
+{
+    "instanceAction": {
+        "action": "resize",
+        "instance_uuid": "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f",
+        "message": "Error",
+        "project_id": "6f70656e737461636b20342065766572",
+        "request_id": "req-3293a3f1-b44c-4609-b8d2-d81b105636b8",
+        "start_time": "2018-04-25T01:26:30.000000",
+        "updated_at": "2018-04-25T01:26:36.000000",
+        "user_id": "admin",
+        "events": [
+            {
+                "event": "compute_prep_resize",
+                "start_time": "2018-04-25T01:26:31.000000",
+                "finish_time": "2018-04-25T01:26:36.000000",
+                "result": "Error",
+                "traceback": "Traceback (most recent call last):\n  File \"nova/compute/manager.py\", line 4200, in prep_resize\nResizeError: Resize error\n",
+                "host": "compute",
+                "hostId": "2091634baaccdc4c5a1d57069c833e402921df696b7f970791b12ec6",
+                "details": "Resize error"
+            }
+        ]
+    }
+}
+`
+
+// FirstInstanceAction is the first instance action in ListOutput.
+var FirstInstanceAction = instanceactions.InstanceAction{
+	Action:       "stop",
+	InstanceUUID: serverID,
+	ProjectID:    "6f70656e737461636b20342065766572",
+	RequestID:    "req-f8a59f03-76dc-412f-92c2-21f8612be728",
+	StartTime:    time.Date(2018, 4, 25, 1, 26, 29, 92607000, time.UTC),
+	UpdatedAt:    time.Date(2018, 4, 25, 1, 26, 29, 372164000, time.UTC),
+	UserID:       "admin",
+}
+
+// SecondInstanceAction is the second instance action in ListOutput.
+var SecondInstanceAction = instanceactions.InstanceAction{
+	Action:       "create",
+	InstanceUUID: serverID,
+	ProjectID:    "6f70656e737461636b20342065766572",
+	RequestID:    "req-50189019-626d-47fb-b944-b8342af09679",
+	StartTime:    time.Date(2018, 4, 25, 1, 26, 25, 0, time.UTC),
+	UpdatedAt:    time.Date(2018, 4, 25, 1, 26, 27, 0, time.UTC),
+	UserID:       "admin",
+}
+
+// ExpectedInstanceActions is the slice of instance actions in ListOutput.
+var ExpectedInstanceActions = []instanceactions.InstanceAction{FirstInstanceAction, SecondInstanceAction}
+
+// ExpectedInstanceActionDetail is the instance action in GetOutput.
+var ExpectedInstanceActionDetail = instanceactions.InstanceActionDetail{
+	InstanceAction: instanceactions.InstanceAction{
+		Action:       "resize",
+		InstanceUUID: serverID,
+		Message:      "Error",
+		ProjectID:    "6f70656e737461636b20342065766572",
+		RequestID:    "req-3293a3f1-b44c-4609-b8d2-d81b105636b8",
+		StartTime:    time.Date(2018, 4, 25, 1, 26, 30, 0, time.UTC),
+		UpdatedAt:    time.Date(2018, 4, 25, 1, 26, 36, 0, time.UTC),
+		UserID:       "admin",
+	},
+	Events: []instanceactions.Event{
+		{
+			Event:      "compute_prep_resize",
+			StartTime:  time.Date(2018, 4, 25, 1, 26, 31, 0, time.UTC),
+			FinishTime: time.Date(2018, 4, 25, 1, 26, 36, 0, time.UTC),
+			Result:     "Error",
+			Traceback:  "Traceback (most recent call last):\n  File \"nova/compute/manager.py\", line 4200, in prep_resize\nResizeError: Resize error\n",
+			Host:       "compute",
+			HostID:     "2091634baaccdc4c5a1d57069c833e402921df696b7f970791b12ec6",
+			Details:    "Resize error",
+		},
+	},
+}
+
+// HandleInstanceActionListSuccessfully sets up the test server to respond to
+// a List request with two pages of instance actions.
+func HandleInstanceActionListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/os-instance-actions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			th.CheckEquals(t, "2018-04-25T00:00:00Z", r.Form.Get("changes-since"))
+			th.CheckEquals(t, "2018-04-26T00:00:00Z", r.Form.Get("changes-before"))
+			fmt.Fprintf(w, ListOutput, th.Endpoint()+"servers/"+serverID+"/os-instance-actions?marker="+SecondInstanceAction.RequestID)
+		case SecondInstanceAction.RequestID:
+			fmt.Fprintf(w, EmptyListOutput)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+// HandleInstanceActionGetSuccessfully sets up the test server to respond to
+// a Get request.
+func HandleInstanceActionGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/os-instance-actions/"+ExpectedInstanceActionDetail.RequestID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GetOutput)
+	})
+}
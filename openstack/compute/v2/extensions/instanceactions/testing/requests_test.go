@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/instanceactions"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceActionListSuccessfully(t)
+
+	changesSince := time.Date(2018, 4, 25, 0, 0, 0, 0, time.UTC)
+	changesBefore := time.Date(2018, 4, 26, 0, 0, 0, 0, time.UTC)
+	opts := instanceactions.ListOpts{
+		ChangesSince:  &changesSince,
+		ChangesBefore: &changesBefore,
+	}
+
+	pages := 0
+	err := instanceactions.List(client.ServiceClient(), serverID, opts).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := instanceactions.ExtractInstanceActions(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedInstanceActions, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, pages)
+}
+
+func TestListOpts(t *testing.T) {
+	changesSince := time.Date(2018, 4, 25, 1, 2, 3, 0, time.UTC)
+	opts := instanceactions.ListOpts{
+		Limit:        10,
+		Marker:       "req-50189019-626d-47fb-b944-b8342af09679",
+		ChangesSince: &changesSince,
+	}
+
+	query, err := opts.ToInstanceActionsListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?changes-since=2018-04-25T01%3A02%3A03Z&limit=10&marker=req-50189019-626d-47fb-b944-b8342af09679", query)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceActionGetSuccessfully(t)
+
+	actual, err := instanceactions.Get(client.ServiceClient(), serverID, ExpectedInstanceActionDetail.RequestID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedInstanceActionDetail, *actual)
+}
@@ -0,0 +1,11 @@
+package instanceactions
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient, serverID string) string {
+	return client.ServiceURL("servers", serverID, "os-instance-actions")
+}
+
+func getURL(client *gophercloud.ServiceClient, serverID, requestID string) string {
+	return client.ServiceURL("servers", serverID, "os-instance-actions", requestID)
+}
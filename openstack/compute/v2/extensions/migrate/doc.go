@@ -1,6 +1,7 @@
 /*
 Package migrate provides functionality to migrate servers that have been
-provisioned by the OpenStack Compute service.
+provisioned by the OpenStack Compute service. The migrations package lists
+the migrations, and monitors, completes or aborts live migrations in progress.
 
 Example of Migrate Server (migrate Action)
 
@@ -0,0 +1,66 @@
+/*
+Package migrations lists the migrations of servers, and monitors and controls
+the live migrations started with the migrate package.
+
+Example to List Migrations
+
+	listOpts := migrations.ListOpts{
+		Host:          "compute-01",
+		Status:        "running",
+		MigrationType: migrations.MigrationTypeLiveMigration,
+	}
+
+	allPages, err := migrations.List(computeClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allMigrations, err := migrations.ExtractMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range allMigrations {
+		fmt.Printf("%+v\n", migration)
+	}
+
+Example to Monitor the Live Migration of a Server
+
+	computeClient.Microversion = "2.24"
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+	allPages, err := migrations.ListServerMigrations(computeClient, serverID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	serverMigrations, err := migrations.ExtractServerMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, m := range serverMigrations {
+		migration, err := migrations.Get(computeClient, serverID, m.ID).Extract()
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("%d of %d bytes of memory remaining\n",
+			migration.MemoryRemainingBytes, migration.MemoryTotalBytes)
+	}
+
+Example to Force a Live Migration to Complete
+
+	err := migrations.ForceComplete(computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Abort a Live Migration
+
+	err := migrations.Abort(computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package migrations
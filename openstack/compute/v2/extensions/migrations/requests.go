@@ -0,0 +1,140 @@
+package migrations
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// MigrationType is the type of a migration.
+type MigrationType string
+
+const (
+	MigrationTypeLiveMigration MigrationType = "live-migration"
+	MigrationTypeMigration     MigrationType = "migration"
+	MigrationTypeResize        MigrationType = "resize"
+	MigrationTypeEvacuation    MigrationType = "evacuation"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMigrationListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of the migrations listed by List.
+type ListOpts struct {
+	// Host filters on migrations from or to the given host.
+	Host string `q:"host"`
+
+	// Status filters on migrations of the given status, such as "running"
+	// or "completed".
+	Status string `q:"status"`
+
+	// MigrationType filters on migrations of the given type.
+	MigrationType MigrationType `q:"migration_type"`
+
+	// SourceCompute filters on migrations from the given compute service.
+	SourceCompute string `q:"source_compute"`
+
+	// InstanceUUID filters on migrations of the given server.
+	InstanceUUID string `q:"instance_uuid"`
+
+	// Limit is the number of migrations to return per page. This requires
+	// microversion 2.59 or later.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last-seen migration. This requires
+	// microversion 2.59 or later.
+	Marker string `q:"marker"`
+
+	// ChangesSince filters on migrations updated at or after the given time.
+	// This requires microversion 2.59 or later.
+	ChangesSince *time.Time
+
+	// ChangesBefore filters on migrations updated at or before the given
+	// time. This requires microversion 2.66 or later.
+	ChangesBefore *time.Time
+
+	// UserID filters on migrations initiated by the given user. This
+	// requires microversion 2.80 or later.
+	UserID string `q:"user_id"`
+
+	// ProjectID filters on migrations of the given project. This requires
+	// microversion 2.80 or later.
+	ProjectID string `q:"project_id"`
+}
+
+// ToMigrationListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMigrationListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+	if opts.ChangesSince != nil {
+		params.Add("changes-since", opts.ChangesSince.Format(time.RFC3339))
+	}
+
+	if opts.ChangesBefore != nil {
+		params.Add("changes-before", opts.ChangesBefore.Format(time.RFC3339))
+	}
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// List makes a request against the API to list the migrations of all
+// servers. This requires administrative privileges by default.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToMigrationListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return MigrationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListServerMigrations makes a request against the API to list the live
+// migrations of a server which are in progress. This requires microversion
+// 2.23 or later.
+func ListServerMigrations(client *gophercloud.ServiceClient, serverID string) pagination.Pager {
+	return pagination.NewPager(client, serverMigrationsURL(client, serverID), func(r pagination.PageResult) pagination.Page {
+		return ServerMigrationPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a live migration of a server which is in progress, including
+// its progress. This requires microversion 2.23 or later.
+func Get(client *gophercloud.ServiceClient, serverID string, migrationID int) (r GetResult) {
+	_, r.Err = client.Get(serverMigrationURL(client, serverID, migrationID), &r.Body, nil)
+	return
+}
+
+// ForceComplete forces a live migration of a server which is in progress to
+// complete, by pausing the server for the remainder of the migration. This
+// requires microversion 2.22 or later.
+func ForceComplete(client *gophercloud.ServiceClient, serverID string, migrationID int) (r ForceCompleteResult) {
+	b := map[string]interface{}{"force_complete": nil}
+	_, r.Err = client.Post(serverMigrationActionURL(client, serverID, migrationID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Abort aborts a live migration of a server which is in progress. This
+// requires microversion 2.24 or later.
+func Abort(client *gophercloud.ServiceClient, serverID string, migrationID int) (r AbortResult) {
+	_, r.Err = client.Delete(serverMigrationURL(client, serverID, migrationID), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,190 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/migrations"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "4cfba335-03d8-49b2-8c52-e69043d1e8fe"
+
+// ListOutput is a sample response to a List request.
+const ListOutput = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-01-29T13:42:02.000000",
+            "dest_compute": "compute2",
+            "dest_host": "1.2.3.4",
+            "dest_node": "node2",
+            "id": 1234,
+            "instance_uuid": "4cfba335-03d8-49b2-8c52-e69043d1e8fe",
+            "new_instance_type_id": 2,
+            "old_instance_type_id": 1,
+            "source_compute": "compute1",
+            "source_node": "node1",
+            "status": "running",
+            "updated_at": "2016-01-29T14:42:02.000000",
+            "migration_type": "live-migration",
+            "uuid": "42341d4b-346a-40d0-83c6-5f4f6892b650",
+            "user_id": "78348f0e-97ee-4d70-ad34-189692673ea2",
+            "project_id": "9842f0f7-1229-4355-afe7-15ebdbb8c3d8"
+        }
+    ],
+    "migrations_links": [
+        {
+            "href": "%s",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// EmptyListOutput is a sample response to a List request past the last page.
+const EmptyListOutput = `
+{
+    "migrations": []
+}
+`
+
+// ServerMigrationOutput is a sample server migration.
+const ServerMigrationOutput = `
+{
+    "created_at": "2016-01-29T13:42:02.000000",
+    "dest_compute": "compute2",
+    "dest_host": "1.2.3.4",
+    "dest_node": "node2",
+    "id": 1234,
+    "server_uuid": "4cfba335-03d8-49b2-8c52-e69043d1e8fe",
+    "source_compute": "compute1",
+    "source_node": "node1",
+    "status": "running",
+    "memory_total_bytes": 123456,
+    "memory_processed_bytes": 12345,
+    "memory_remaining_bytes": 111111,
+    "disk_total_bytes": 234567,
+    "disk_processed_bytes": 23456,
+    "disk_remaining_bytes": 211111,
+    "updated_at": "2016-01-29T13:42:02.000000",
+    "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650",
+    "user_id": "8dbaa0f0-ab95-4ffe-8cb4-9c89d2ac9d24",
+    "project_id": "5f705771-3aa9-4f4c-8660-0d9522ffdbea"
+}
+`
+
+// ExpectedMigration is the migration in ListOutput.
+var ExpectedMigration = migrations.Migration{
+	ID:                1234,
+	UUID:              "42341d4b-346a-40d0-83c6-5f4f6892b650",
+	InstanceUUID:      serverID,
+	MigrationType:     migrations.MigrationTypeLiveMigration,
+	Status:            "running",
+	SourceCompute:     "compute1",
+	SourceNode:        "node1",
+	DestCompute:       "compute2",
+	DestHost:          "1.2.3.4",
+	DestNode:          "node2",
+	OldInstanceTypeID: 1,
+	NewInstanceTypeID: 2,
+	UserID:            "78348f0e-97ee-4d70-ad34-189692673ea2",
+	ProjectID:         "9842f0f7-1229-4355-afe7-15ebdbb8c3d8",
+	CreatedAt:         time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+	UpdatedAt:         time.Date(2016, 1, 29, 14, 42, 2, 0, time.UTC),
+}
+
+// ExpectedServerMigration is the migration in ServerMigrationOutput.
+var ExpectedServerMigration = migrations.ServerMigration{
+	ID:                   1234,
+	UUID:                 "12341d4b-346a-40d0-83c6-5f4f6892b650",
+	ServerUUID:           serverID,
+	Status:               "running",
+	SourceCompute:        "compute1",
+	SourceNode:           "node1",
+	DestCompute:          "compute2",
+	DestHost:             "1.2.3.4",
+	DestNode:             "node2",
+	MemoryTotalBytes:     123456,
+	MemoryProcessedBytes: 12345,
+	MemoryRemainingBytes: 111111,
+	DiskTotalBytes:       234567,
+	DiskProcessedBytes:   23456,
+	DiskRemainingBytes:   211111,
+	UserID:               "8dbaa0f0-ab95-4ffe-8cb4-9c89d2ac9d24",
+	ProjectID:            "5f705771-3aa9-4f4c-8660-0d9522ffdbea",
+	CreatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+	UpdatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+}
+
+// HandleMigrationListSuccessfully sets up the test server to respond to a
+// List request with two pages of migrations.
+func HandleMigrationListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		th.CheckEquals(t, "compute1", r.Form.Get("host"))
+		th.CheckEquals(t, "live-migration", r.Form.Get("migration_type"))
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			fmt.Fprintf(w, ListOutput, th.Endpoint()+"os-migrations?host=compute1&migration_type=live-migration&marker="+ExpectedMigration.UUID)
+		case ExpectedMigration.UUID:
+			fmt.Fprintf(w, EmptyListOutput)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+// HandleServerMigrationListSuccessfully sets up the test server to respond
+// to a ListServerMigrations request.
+func HandleServerMigrationListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"migrations": [%s]}`, ServerMigrationOutput)
+	})
+}
+
+// HandleServerMigrationGetSuccessfully sets up the test server to respond to
+// a Get request.
+func HandleServerMigrationGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"migration": %s}`, ServerMigrationOutput)
+	})
+}
+
+// HandleServerMigrationForceCompleteSuccessfully sets up the test server to
+// respond to a ForceComplete request.
+func HandleServerMigrationForceCompleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1234/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"force_complete": null}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleServerMigrationAbortSuccessfully sets up the test server to respond
+// to an Abort request.
+func HandleServerMigrationAbortSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/migrations"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMigrationListSuccessfully(t)
+
+	opts := migrations.ListOpts{
+		Host:          "compute1",
+		MigrationType: migrations.MigrationTypeLiveMigration,
+	}
+
+	pages := 0
+	err := migrations.List(client.ServiceClient(), opts).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := migrations.ExtractMigrations(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []migrations.Migration{ExpectedMigration}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, pages)
+}
+
+func TestListServerMigrations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerMigrationListSuccessfully(t)
+
+	allPages, err := migrations.ListServerMigrations(client.ServiceClient(), serverID).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := migrations.ExtractServerMigrations(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []migrations.ServerMigration{ExpectedServerMigration}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerMigrationGetSuccessfully(t)
+
+	actual, err := migrations.Get(client.ServiceClient(), serverID, 1234).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedServerMigration, *actual)
+}
+
+func TestForceComplete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerMigrationForceCompleteSuccessfully(t)
+
+	err := migrations.ForceComplete(client.ServiceClient(), serverID, 1234).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAbort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerMigrationAbortSuccessfully(t)
+
+	err := migrations.Abort(client.ServiceClient(), serverID, 1234).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,23 @@
+package migrations
+
+import (
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("os-migrations")
+}
+
+func serverMigrationsURL(client *gophercloud.ServiceClient, serverID string) string {
+	return client.ServiceURL("servers", serverID, "migrations")
+}
+
+func serverMigrationURL(client *gophercloud.ServiceClient, serverID string, migrationID int) string {
+	return client.ServiceURL("servers", serverID, "migrations", strconv.Itoa(migrationID))
+}
+
+func serverMigrationActionURL(client *gophercloud.ServiceClient, serverID string, migrationID int) string {
+	return client.ServiceURL("servers", serverID, "migrations", strconv.Itoa(migrationID), "action")
+}
@@ -0,0 +1,36 @@
+/*
+Package remoteconsoles provides the ability to create server remote consoles
+through the Compute API, and to retrieve the URL a browser can connect to.
+
+The remote-consoles API requires microversion 2.6 or later, and the MKS
+protocol requires microversion 2.8 or later. Older deployments expose the
+same consoles through server actions, which CreateLegacy uses.
+
+Example of Creating a new RemoteConsole
+
+	computeClient, err := openstack.NewComputeV2(providerClient, endpointOpts)
+	computeClient.Microversion = "2.6"
+
+	createOpts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	remoteConsole, err := remoteconsoles.Create(computeClient, serverID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Console URL: %s\n", remoteConsole.URL)
+
+Example of Creating a RemoteConsole with a Microversion older than 2.6
+
+	remoteConsole, err := remoteconsoles.CreateLegacy(computeClient, serverID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Console URL: %s\n", remoteConsole.URL)
+*/
+package remoteconsoles
@@ -0,0 +1,141 @@
+package remoteconsoles
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ConsoleProtocol represents valid remote console protocol.
+// It can be used to create a remote console with one of the pre-defined protocol.
+type ConsoleProtocol string
+
+const (
+	// ConsoleProtocolVNC represents the VNC console protocol.
+	ConsoleProtocolVNC ConsoleProtocol = "vnc"
+
+	// ConsoleProtocolSPICE represents the SPICE console protocol.
+	ConsoleProtocolSPICE ConsoleProtocol = "spice"
+
+	// ConsoleProtocolRDP represents the RDP console protocol.
+	ConsoleProtocolRDP ConsoleProtocol = "rdp"
+
+	// ConsoleProtocolSerial represents the Serial console protocol.
+	ConsoleProtocolSerial ConsoleProtocol = "serial"
+
+	// ConsoleProtocolMKS represents the MKS console protocol. It requires
+	// microversion 2.8 or later, and cannot be used with CreateLegacy.
+	ConsoleProtocolMKS ConsoleProtocol = "mks"
+)
+
+// ConsoleType represents valid remote console type.
+// It can be used to create a remote console with one of the pre-defined type.
+type ConsoleType string
+
+const (
+	// ConsoleTypeNoVNC represents the VNC console type.
+	ConsoleTypeNoVNC ConsoleType = "novnc"
+
+	// ConsoleTypeXVPVNC represents the XVP VNC console type.
+	ConsoleTypeXVPVNC ConsoleType = "xvpvnc"
+
+	// ConsoleTypeRDPHTML5 represents the RDP HTML5 console type.
+	ConsoleTypeRDPHTML5 ConsoleType = "rdp-html5"
+
+	// ConsoleTypeSPICEHTML5 represents the SPICE HTML5 console type.
+	ConsoleTypeSPICEHTML5 ConsoleType = "spice-html5"
+
+	// ConsoleTypeSerial represents the Serial console type.
+	ConsoleTypeSerial ConsoleType = "serial"
+
+	// ConsoleTypeWebMKS represents the Web MKS console type.
+	ConsoleTypeWebMKS ConsoleType = "webmks"
+)
+
+// legacyActions maps the console protocols to the server actions used to
+// create their consoles before microversion 2.6.
+var legacyActions = map[ConsoleProtocol]string{
+	ConsoleProtocolVNC:    "os-getVNCConsole",
+	ConsoleProtocolSPICE:  "os-getSPICEConsole",
+	ConsoleProtocolRDP:    "os-getRDPConsole",
+	ConsoleProtocolSerial: "os-getSerialConsole",
+}
+
+// CreateOptsBuilder allows to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToRemoteConsoleCreateMap() (map[string]interface{}, error)
+}
+
+// LegacyCreateOptsBuilder allows to add additional parameters to the
+// CreateLegacy request.
+type LegacyCreateOptsBuilder interface {
+	ToRemoteConsoleLegacyCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters to the Create and CreateLegacy requests.
+type CreateOpts struct {
+	// Protocol specifies the protocol of a new remote console.
+	Protocol ConsoleProtocol `json:"protocol" required:"true"`
+
+	// Type specifies the type of a new remote console.
+	Type ConsoleType `json:"type" required:"true"`
+}
+
+// ToRemoteConsoleCreateMap builds a request body from the CreateOpts.
+func (opts CreateOpts) ToRemoteConsoleCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "remote_console")
+}
+
+// ToRemoteConsoleLegacyCreateMap builds the body of the server action
+// matching the protocol of the CreateOpts.
+func (opts CreateOpts) ToRemoteConsoleLegacyCreateMap() (map[string]interface{}, error) {
+	action, ok := legacyActions[opts.Protocol]
+	if !ok {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "remoteconsoles.CreateOpts.Protocol"
+		err.Value = opts.Protocol
+		err.Info = fmt.Sprintf("no legacy console action exists for protocol %q", opts.Protocol)
+		return nil, err
+	}
+
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	delete(b, "protocol")
+
+	return map[string]interface{}{action: b}, nil
+}
+
+// Create requests the creation of a new remote console on the specified
+// server. It requires microversion 2.6 or later; use CreateLegacy with
+// older microversions.
+func Create(client *gophercloud.ServiceClient, serverID string, opts CreateOptsBuilder) (r CreateResult) {
+	reqBody, err := opts.ToRemoteConsoleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(createURL(client, serverID), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// CreateLegacy requests the creation of a new remote console on the
+// specified server through the os-getVNCConsole, os-getSPICEConsole,
+// os-getRDPConsole and os-getSerialConsole server actions, which are
+// available up to microversion 2.5.
+func CreateLegacy(client *gophercloud.ServiceClient, serverID string, opts LegacyCreateOptsBuilder) (r CreateResult) {
+	reqBody, err := opts.ToRemoteConsoleLegacyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(actionURL(client, serverID), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,39 @@
+package remoteconsoles
+
+import "github.com/gophercloud/gophercloud"
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a RemoteConsole.
+type CreateResult struct {
+	commonResult
+}
+
+// RemoteConsole represents the Compute service remote console object.
+type RemoteConsole struct {
+	// Protocol contains remote console protocol. It is empty for the
+	// consoles created with CreateLegacy.
+	Protocol string `json:"protocol"`
+
+	// Type contains remote console type.
+	Type string `json:"type"`
+
+	// URL can be used to connect to the remote console.
+	URL string `json:"url"`
+}
+
+// Extract interprets any commonResult as a RemoteConsole.
+func (r commonResult) Extract() (*RemoteConsole, error) {
+	var s struct {
+		RemoteConsole *RemoteConsole `json:"remote_console"`
+		Console       *RemoteConsole `json:"console"`
+	}
+	err := r.ExtractInto(&s)
+	if s.RemoteConsole == nil {
+		return s.Console, err
+	}
+	return s.RemoteConsole, err
+}
@@ -0,0 +1,2 @@
+// remoteconsoles unit tests
+package testing
@@ -0,0 +1,43 @@
+package testing
+
+// RemoteConsoleCreateRequest represents a request to create a remote console.
+const RemoteConsoleCreateRequest = `
+{
+    "remote_console": {
+        "protocol": "vnc",
+        "type": "novnc"
+    }
+}
+`
+
+// RemoteConsoleCreateResult represents a result of a create remote console request.
+const RemoteConsoleCreateResult = `
+{
+    "remote_console": {
+        "protocol": "vnc",
+        "type": "novnc",
+        "url": "http://192.168.0.4:6080/vnc_auto.html?token=9a2372b9-6a0e-4f71-aca1-56020e6bb677"
+    }
+}
+`
+
+// LegacyConsoleCreateRequest represents a request to create a VNC console
+// through a server action.
+const LegacyConsoleCreateRequest = `
+{
+    "os-getVNCConsole": {
+        "type": "novnc"
+    }
+}
+`
+
+// LegacyConsoleCreateResult represents a result of a server action creating
+// a VNC console.
+const LegacyConsoleCreateResult = `
+{
+    "console": {
+        "type": "novnc",
+        "url": "http://192.168.0.4:6080/vnc_auto.html?token=9a2372b9-6a0e-4f71-aca1-56020e6bb677"
+    }
+}
+`
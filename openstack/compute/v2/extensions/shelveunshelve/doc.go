@@ -0,0 +1,39 @@
+/*
+Package shelveunshelve provides functionality to shelve, shelve-offload and
+unshelve servers that have been provisioned by the OpenStack Compute service.
+
+A shelved server keeps its resources on its host, while a shelve-offloaded
+server releases them until it is unshelved. The extendedstatus package
+reports the progress of these actions.
+
+Example to Shelve, Shelve-offload and Unshelve a Server
+
+	serverID := "47b6b7b7-568d-40e4-868c-d5c41735532e"
+	err := shelveunshelve.Shelve(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = shelveunshelve.ShelveOffload(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = shelveunshelve.Unshelve(computeClient, serverID, nil).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Unshelve a Server into a specific Availability Zone
+
+	unshelveOpts := shelveunshelve.UnshelveOpts{
+		AvailabilityZone: "us-east-1",
+	}
+
+	computeClient.Microversion = "2.77"
+	err := shelveunshelve.Unshelve(computeClient, serverID, unshelveOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package shelveunshelve
@@ -0,0 +1,63 @@
+package shelveunshelve
+
+import "github.com/gophercloud/gophercloud"
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Shelve is the operation responsible for shelving a Compute server.
+func Shelve(client *gophercloud.ServiceClient, id string) (r ShelveResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"shelve": nil}, nil, nil)
+	return
+}
+
+// ShelveOffload is the operation responsible for shelve-offloading a Compute
+// server, which releases the resources it holds on its host.
+func ShelveOffload(client *gophercloud.ServiceClient, id string) (r ShelveOffloadResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"shelveOffload": nil}, nil, nil)
+	return
+}
+
+// UnshelveOptsBuilder allows extensions to add additional parameters to the
+// Unshelve request.
+type UnshelveOptsBuilder interface {
+	ToUnshelveMap() (map[string]interface{}, error)
+}
+
+// UnshelveOpts specifies parameters of the Unshelve action.
+type UnshelveOpts struct {
+	// AvailabilityZone is the availability zone to unshelve a shelved
+	// offloaded server into. This requires microversion 2.77 or later.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToUnshelveMap builds a request body from UnshelveOpts.
+func (opts UnshelveOpts) ToUnshelveMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) == 0 {
+		return map[string]interface{}{"unshelve": nil}, nil
+	}
+
+	return map[string]interface{}{"unshelve": b}, nil
+}
+
+// Unshelve is the operation responsible for unshelving a Compute server.
+// Options may be nil.
+func Unshelve(client *gophercloud.ServiceClient, id string, opts UnshelveOptsBuilder) (r UnshelveResult) {
+	b := map[string]interface{}{"unshelve": nil}
+	if opts != nil {
+		var err error
+		b, err = opts.ToUnshelveMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	return
+}
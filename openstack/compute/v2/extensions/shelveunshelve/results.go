@@ -0,0 +1,21 @@
+package shelveunshelve
+
+import "github.com/gophercloud/gophercloud"
+
+// ShelveResult is the response from a Shelve operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type ShelveResult struct {
+	gophercloud.ErrResult
+}
+
+// ShelveOffloadResult is the response from a ShelveOffload operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type ShelveOffloadResult struct {
+	gophercloud.ErrResult
+}
+
+// UnshelveResult is the response from an Unshelve operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type UnshelveResult struct {
+	gophercloud.ErrResult
+}
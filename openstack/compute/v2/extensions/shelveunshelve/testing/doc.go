@@ -0,0 +1,2 @@
+// shelveunshelve unit tests
+package testing
@@ -0,0 +1,72 @@
+/*
+Package tags manages the tags of servers through the Compute API. Tags are
+simple strings attached to a server which, unlike its metadata, can be used
+to filter the servers listed by servers.List.
+
+The tags API requires microversion 2.26 or later.
+
+Example to List all Server Tags
+
+	client.Microversion = "2.26"
+
+	serverTags, err := tags.List(client, serverID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Tags: %v\n", serverTags)
+
+Example to Check if the specific Tag exists on a Server
+
+	client.Microversion = "2.26"
+
+	exists, err := tags.Check(client, serverID, tag).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	if exists {
+		fmt.Printf("Tag %s is set\n", tag)
+	} else {
+		fmt.Printf("Tag %s is not set\n", tag)
+	}
+
+Example to Replace all Tags on a Server
+
+	client.Microversion = "2.26"
+
+	newTags, err := tags.ReplaceAll(client, serverID, tags.ReplaceAllOpts{Tags: []string{"foo", "bar"}}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("New tags: %v\n", newTags)
+
+Example to Add a new Tag on a Server
+
+	client.Microversion = "2.26"
+
+	err := tags.Add(client, serverID, "foo").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Tag on a Server
+
+	client.Microversion = "2.26"
+
+	err := tags.Delete(client, serverID, "foo").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete all Tags on a Server
+
+	client.Microversion = "2.26"
+
+	err := tags.DeleteAll(client, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package tags
@@ -0,0 +1,79 @@
+package tags
+
+import "github.com/gophercloud/gophercloud"
+
+// List all tags on a server.
+func List(client *gophercloud.ServiceClient, serverID string) (r ListResult) {
+	_, r.Err = client.Get(listURL(client, serverID), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Check if a tag exists on a server. Call the Extract method of the result
+// to get the answer.
+func Check(client *gophercloud.ServiceClient, serverID, tag string) (r CheckResult) {
+	_, r.Err = client.Get(tagURL(client, serverID, tag), nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ReplaceAllOptsBuilder allows to add additional parameters to the ReplaceAll
+// request.
+type ReplaceAllOptsBuilder interface {
+	ToTagsReplaceAllMap() (map[string]interface{}, error)
+}
+
+// ReplaceAllOpts provides options used to replace the tags on a server.
+type ReplaceAllOpts struct {
+	// Tags is the new set of tags of the server. An empty set removes all
+	// the tags of the server.
+	Tags []string `json:"tags"`
+}
+
+// ToTagsReplaceAllMap formats a ReplaceAllOpts into the body of the
+// ReplaceAll request.
+func (opts ReplaceAllOpts) ToTagsReplaceAllMap() (map[string]interface{}, error) {
+	if opts.Tags == nil {
+		opts.Tags = []string{}
+	}
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ReplaceAll replaces all tags on a server.
+func ReplaceAll(client *gophercloud.ServiceClient, serverID string, opts ReplaceAllOptsBuilder) (r ReplaceAllResult) {
+	b, err := opts.ToTagsReplaceAllMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(listURL(client, serverID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Add adds a tag to a server.
+func Add(client *gophercloud.ServiceClient, serverID, tag string) (r AddResult) {
+	_, r.Err = client.Put(tagURL(client, serverID, tag), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{201, 204},
+	})
+	return
+}
+
+// Delete removes a tag from a server.
+func Delete(client *gophercloud.ServiceClient, serverID, tag string) (r DeleteResult) {
+	_, r.Err = client.Delete(tagURL(client, serverID, tag), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// DeleteAll removes all tags from a server.
+func DeleteAll(client *gophercloud.ServiceClient, serverID string) (r DeleteResult) {
+	_, r.Err = client.Delete(listURL(client, serverID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,55 @@
+package tags
+
+import "github.com/gophercloud/gophercloud"
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets commonResult as a slice of tags.
+func (r commonResult) Extract() ([]string, error) {
+	var s struct {
+		Tags []string `json:"tags"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Tags, err
+}
+
+// ListResult is the result of a List request. Call its Extract method to
+// interpret it as a slice of tags.
+type ListResult struct {
+	commonResult
+}
+
+// CheckResult is the result of a Check request. Call its Extract method to
+// determine if the tag exists.
+type CheckResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract interprets a CheckResult as a bool. It is true if the tag exists,
+// and false if the server does not have it.
+func (r CheckResult) Extract() (bool, error) {
+	if gophercloud.ResponseCodeIs(r.Err, 404) {
+		return false, nil
+	}
+	return r.Err == nil, r.Err
+}
+
+// ReplaceAllResult is the result of a ReplaceAll request. Call its Extract
+// method to interpret it as a slice of tags.
+type ReplaceAllResult struct {
+	commonResult
+}
+
+// AddResult is the result of an Add request. Call its ExtractErr method to
+// determine if the request succeeded or failed.
+type AddResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult is the result of a Delete or DeleteAll request. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,11 @@
+package tags
+
+import "github.com/gophercloud/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient, serverID string) string {
+	return client.ServiceURL("servers", serverID, "tags")
+}
+
+func tagURL(client *gophercloud.ServiceClient, serverID, tag string) string {
+	return client.ServiceURL("servers", serverID, "tags", tag)
+}
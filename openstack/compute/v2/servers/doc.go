@@ -111,5 +111,18 @@ Example to Snapshot a Server
 	if err != nil {
 		panic(err)
 	}
+
+Example to Wait for a Server to Become Active
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	waiter := servers.NewWaiter(computeClient, serverID, "ACTIVE")
+	waiter.Timeout = 10 * time.Minute
+
+	s, err := waiter.Wait(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	server := s.(*servers.Server)
 */
 package servers
@@ -55,6 +55,22 @@ type ListOpts struct {
 	// TenantID lists servers for a particular tenant.
 	// Setting "AllTenants = true" is required.
 	TenantID string `q:"tenant_id"`
+
+	// Tags filters on servers which have all of the given tags, as a
+	// comma-separated list. This requires microversion 2.26 or later.
+	Tags string `q:"tags"`
+
+	// TagsAny filters on servers which have any of the given tags, as a
+	// comma-separated list. This requires microversion 2.26 or later.
+	TagsAny string `q:"tags-any"`
+
+	// NotTags filters on servers which do not have all of the given tags, as
+	// a comma-separated list. This requires microversion 2.26 or later.
+	NotTags string `q:"not-tags"`
+
+	// NotTagsAny filters on servers which do not have any of the given tags,
+	// as a comma-separated list. This requires microversion 2.26 or later.
+	NotTagsAny string `q:"not-tags-any"`
 }
 
 // ToServerListQuery formats a ListOpts into a query string.
@@ -227,6 +227,10 @@ type Server struct {
 	// to it.
 	SecurityGroups []map[string]interface{} `json:"security_groups"`
 
+	// Tags is a slice of the server tags. It is only returned with
+	// microversion 2.26 or later. The tags package manages them.
+	Tags []string `json:"tags"`
+
 	// Fault contains failure information about a server.
 	Fault Fault `json:"fault"`
 }
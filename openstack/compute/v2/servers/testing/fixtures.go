@@ -3,6 +3,7 @@ package testing
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -805,6 +806,23 @@ func HandleServerGetSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleServerGetStatusesSuccessfully sets up the test server to respond to
+// successive server Get requests with a server in each of the given statuses.
+// The last status is repeated once all of them were returned.
+func HandleServerGetStatusesSuccessfully(t *testing.T, statuses ...string) {
+	var i int
+	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		status := statuses[i]
+		if i < len(statuses)-1 {
+			i++
+		}
+		fmt.Fprintf(w, strings.Replace(SingleServerBody, `"status": "ACTIVE"`, `"status": "`+status+`"`, 1))
+	})
+}
+
 // HandleServerGetFaultSuccessfully sets up the test server to respond to a server Get
 // request which contains a fault.
 func HandleServerGetFaultSuccessfully(t *testing.T) {
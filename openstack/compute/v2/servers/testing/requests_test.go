@@ -1,11 +1,14 @@
 package testing
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/diskconfig"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedstatus"
@@ -564,3 +567,62 @@ func TestMarshalPersonality(t *testing.T) {
 		t.Fatal("file contents incorrect")
 	}
 }
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetSuccessfully(t)
+
+	err := servers.WaitForStatus(client.ServiceClient(), "1234asdf", "ACTIVE", 10)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaiterFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetStatusesSuccessfully(t, "BUILD", "ERROR")
+
+	w := servers.NewWaiter(client.ServiceClient(), "1234asdf", "ACTIVE")
+	w.Interval = time.Millisecond
+
+	var states []string
+	w.Progress = func(state string, elapsed time.Duration) {
+		states = append(states, state)
+	}
+
+	_, err := w.Wait(context.Background())
+	e, ok := err.(gophercloud.ErrWaitFailure)
+	if !ok {
+		t.Fatalf("Expected ErrWaitFailure, got %#v", err)
+	}
+	th.AssertEquals(t, "ERROR", e.State)
+	th.AssertEquals(t, "ERROR", e.Resource.(*servers.Server).Status)
+	th.AssertDeepEquals(t, []string{"BUILD", "ERROR"}, states)
+}
+
+func TestListOptsTags(t *testing.T) {
+	opts := servers.ListOpts{
+		Tags:       "foo,bar",
+		TagsAny:    "baz",
+		NotTags:    "qux",
+		NotTagsAny: "quux,corge",
+	}
+
+	query, err := opts.ToServerListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?not-tags=qux&not-tags-any=quux%2Ccorge&tags=foo%2Cbar&tags-any=baz", query)
+}
+
+func TestWaitForStatusZeroTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %s", r.URL)
+	})
+
+	err := servers.WaitForStatus(client.ServiceClient(), "1234", "ACTIVE", 0)
+	if _, ok := err.(gophercloud.ErrTimeOut); !ok {
+		t.Fatalf("Expected ErrTimeOut, got %#v", err)
+	}
+}
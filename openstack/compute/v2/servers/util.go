@@ -1,21 +1,44 @@
 package servers
 
-import "github.com/gophercloud/gophercloud"
+import (
+	"context"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
 
 // WaitForStatus will continually poll a server until it successfully
 // transitions to a specified status. It will do this for at most the number
-// of seconds specified.
+// of seconds specified, and stops early if the server goes into ERROR.
+// A negative secs waits without a timeout.
 func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
-	return gophercloud.WaitFor(secs, func() (bool, error) {
-		current, err := Get(c, id).Extract()
-		if err != nil {
-			return false, err
-		}
+	if secs == 0 {
+		return gophercloud.ErrTimeOut{}
+	}
 
-		if current.Status == status {
-			return true, nil
-		}
+	w := NewWaiter(c, id, status)
+	w.Interval = time.Second
+	w.MaxInterval = time.Second
+	if secs > 0 {
+		w.Timeout = time.Duration(secs) * time.Second
+	}
+	_, err := w.Wait(context.Background())
+	return err
+}
 
-		return false, nil
-	})
+// NewWaiter returns a Waiter which polls a server until its status is one of
+// target. A server in ERROR is a failure. The Wait method of the Waiter
+// returns the server as a *Server.
+func NewWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.Waiter {
+	return &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			s, err := Get(c.WithContext(ctx), id).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return s, s.Status, nil
+		},
+		Target:  target,
+		Failure: []string{"ERROR"},
+	}
 }
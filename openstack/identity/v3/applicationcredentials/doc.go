@@ -0,0 +1,84 @@
+/*
+Package applicationcredentials provides information and interaction with the
+application credentials API resource for the OpenStack Identity service.
+
+Application credentials allow applications to authenticate as a user, with
+a subset of that user's roles on a single project, and without exposing the
+user's password. See gophercloud.AuthOptions for how to authenticate with
+them.
+
+For more information, see:
+https://docs.openstack.org/api-ref/identity/v3/#application-credentials
+
+Example to List ApplicationCredentials
+
+	allPages, err := applicationcredentials.List(identityClient, userID, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allApplicationCredentials, err := applicationcredentials.ExtractApplicationCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, applicationCredential := range allApplicationCredentials {
+		fmt.Printf("%+v\n", applicationCredential)
+	}
+
+Example to Get an ApplicationCredential
+
+	applicationCredential, err := applicationcredentials.Get(identityClient, userID, applicationID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an ApplicationCredential
+
+	createOpts := applicationcredentials.CreateOpts{
+		Name:        "test",
+		Description: "description",
+		Roles: []applicationcredentials.Role{
+			applicationcredentials.Role{ID: "31f87923ae4a4d119aa0b85dcdbeed13"},
+		},
+		AccessRules: []applicationcredentials.AccessRule{
+			applicationcredentials.AccessRule{
+				Path:    "/v2.1/servers",
+				Method:  "GET",
+				Service: "compute",
+			},
+		},
+	}
+
+	applicationCredential, err := applicationcredentials.Create(identityClient, userID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an ApplicationCredential
+
+	err := applicationcredentials.Delete(identityClient, userID, applicationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Access Rules
+
+	allPages, err := applicationcredentials.ListAccessRules(identityClient, userID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAccessRules, err := applicationcredentials.ExtractAccessRules(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Access Rule
+
+	err := applicationcredentials.DeleteAccessRule(identityClient, userID, accessRuleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package applicationcredentials
@@ -0,0 +1,145 @@
+package applicationcredentials
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request
+type ListOptsBuilder interface {
+	ToApplicationCredentialListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// Name filters the response by an application credential name
+	Name string `q:"name"`
+}
+
+// ToApplicationCredentialListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToApplicationCredentialListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the ApplicationCredentials of a user.
+func List(client *gophercloud.ServiceClient, userID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client, userID)
+	if opts != nil {
+		query, err := opts.ToApplicationCredentialListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ApplicationCredentialPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single application credential, by ID.
+func Get(client *gophercloud.ServiceClient, userID string, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, userID, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToApplicationCredentialCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create an application credential.
+type CreateOpts struct {
+	// The name of the application credential.
+	Name string `json:"name,omitempty" required:"true"`
+
+	// A description of the application credential’s purpose.
+	Description string `json:"description,omitempty"`
+
+	// A flag indicating whether the application credential may be used for
+	// creation or destruction of other application credentials or trusts.
+	// Defaults to false
+	Unrestricted bool `json:"unrestricted"`
+
+	// The secret for the application credential, either generated by the
+	// server or provided by the user. This is only ever shown once in the
+	// response to a create request. It is not stored nor ever shown again.
+	// If the secret is lost, a new application credential must be created.
+	Secret string `json:"secret,omitempty"`
+
+	// A list of one or more roles that this application credential has
+	// associated with its project. A token using this application credential
+	// will have these same roles. The roles must be a subset of the roles the
+	// user has on the project. Defaults to all of the user's current roles.
+	Roles []Role `json:"roles,omitempty"`
+
+	// A list of access rules objects, restricting the API requests which may
+	// be made with the application credential.
+	AccessRules []AccessRule `json:"access_rules,omitempty"`
+
+	// The expiration time of the application credential, if one was specified.
+	ExpiresAt *time.Time `json:"-"`
+}
+
+// ToApplicationCredentialCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToApplicationCredentialCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "application_credential")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ExpiresAt != nil {
+		if v, ok := b["application_credential"].(map[string]interface{}); ok {
+			v["expires_at"] = opts.ExpiresAt.UTC().Format(gophercloud.RFC3339MilliNoZ)
+		}
+	}
+
+	return b, nil
+}
+
+// Create creates a new ApplicationCredential.
+func Create(client *gophercloud.ServiceClient, userID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToApplicationCredentialCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, userID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Delete deletes an application credential.
+func Delete(client *gophercloud.ServiceClient, userID string, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, userID, id), nil)
+	return
+}
+
+// ListAccessRules enumerates the access rules of a user. Access rules are
+// created along with the application credentials using them, and remain
+// available for reuse until every application credential using them is
+// deleted.
+func ListAccessRules(client *gophercloud.ServiceClient, userID string) pagination.Pager {
+	url := listAccessRulesURL(client, userID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return AccessRulePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetAccessRule retrieves details on a single access rule by ID.
+func GetAccessRule(client *gophercloud.ServiceClient, userID string, id string) (r GetAccessRuleResult) {
+	_, r.Err = client.Get(getAccessRuleURL(client, userID, id), &r.Body, nil)
+	return
+}
+
+// DeleteAccessRule deletes an access rule which is not used by any
+// application credential.
+func DeleteAccessRule(client *gophercloud.ServiceClient, userID string, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteAccessRuleURL(client, userID, id), nil)
+	return
+}
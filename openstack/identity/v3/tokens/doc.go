@@ -104,5 +104,20 @@ Example to Create a Token from a Username and Password with Project Name Scope
 		panic(err)
 	}
 
+Example to Create a Token from an Application Credential
+
+Application credentials are already scoped to the project they were created
+in, so no Scope is needed.
+
+	authOptions := tokens.AuthOptions{
+		ApplicationCredentialID:     "application_credential_id",
+		ApplicationCredentialSecret: "application_credential_secret",
+	}
+
+	token, err = tokens.Create(identityClient, &authOptions).ExtractToken()
+	if err != nil {
+		panic(err)
+	}
+
 */
 package tokens
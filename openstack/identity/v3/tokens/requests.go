@@ -53,6 +53,19 @@ type AuthOptions struct {
 	TokenID string `json:"-"`
 
 	Scope Scope `json:"-"`
+
+	// Authentication through Application Credentials requires supplying the
+	// ApplicationCredentialSecret along with either the
+	// ApplicationCredentialID, in which case no user is needed at all, or the
+	// ApplicationCredentialName. As names are only unique per user, the name
+	// must come with the UserID, or with the Username and the DomainID or
+	// DomainName of the user.
+	// Application credentials are scoped to the project they were created in,
+	// so Scope is ignored when using them. They also take precedence over
+	// Password and TokenID.
+	ApplicationCredentialID     string `json:"-"`
+	ApplicationCredentialName   string `json:"-"`
+	ApplicationCredentialSecret string `json:"-"`
 }
 
 // ToTokenV3CreateMap builds a request body from AuthOptions.
@@ -65,6 +78,10 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 		DomainName:  opts.DomainName,
 		AllowReauth: opts.AllowReauth,
 		TokenID:     opts.TokenID,
+
+		ApplicationCredentialID:     opts.ApplicationCredentialID,
+		ApplicationCredentialName:   opts.ApplicationCredentialName,
+		ApplicationCredentialSecret: opts.ApplicationCredentialSecret,
 	}
 
 	return gophercloudAuthOpts.ToTokenV3CreateMap(scope)
@@ -78,6 +95,9 @@ func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
 		Scope:      &scope,
 		DomainID:   opts.DomainID,
 		DomainName: opts.DomainName,
+
+		ApplicationCredentialID:   opts.ApplicationCredentialID,
+		ApplicationCredentialName: opts.ApplicationCredentialName,
 	}
 
 	return gophercloudAuthOpts.ToTokenV3ScopeMap()
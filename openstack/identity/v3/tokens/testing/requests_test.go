@@ -144,6 +144,134 @@ func TestCreateTokenID(t *testing.T) {
 	`)
 }
 
+func TestCreateApplicationCredentialIDAndSecret(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "mysecret"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"id": "12345abcdef",
+						"secret": "mysecret"
+					},
+					"methods": [
+						"application_credential"
+					]
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateApplicationCredentialNameAndSecret(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialName: "myappcred", ApplicationCredentialSecret: "mysecret", Username: "fenris", DomainName: "default"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"name": "myappcred",
+						"secret": "mysecret",
+						"user": {
+							"name": "fenris",
+							"domain": {
+								"name": "default"
+							}
+						}
+					},
+					"methods": [
+						"application_credential"
+					]
+				}
+			}
+		}
+	`)
+
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialName: "myappcred", ApplicationCredentialSecret: "mysecret", UserID: "12345"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"name": "myappcred",
+						"secret": "mysecret",
+						"user": {
+							"id": "12345"
+						}
+					},
+					"methods": [
+						"application_credential"
+					]
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateApplicationCredentialTakesPrecedence(t *testing.T) {
+	// A password or a token left over along with the application credential,
+	// for instance from OS_PASSWORD, must not be used in its place.
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "mysecret", UserID: "me", Password: "swordfish"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"id": "12345abcdef",
+						"secret": "mysecret"
+					},
+					"methods": [
+						"application_credential"
+					]
+				}
+			}
+		}
+	`)
+
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialName: "myappcred", ApplicationCredentialSecret: "mysecret", Username: "fenris", DomainName: "default", Password: "swordfish", TokenID: "12345abcdef"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"name": "myappcred",
+						"secret": "mysecret",
+						"user": {
+							"name": "fenris",
+							"domain": {
+								"name": "default"
+							}
+						}
+					},
+					"methods": [
+						"application_credential"
+					]
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateFailureAppCredMissingSecretWithPassword(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", UserID: "me", Password: "swordfish"}
+	authTokenPostErr(t, options, nil, false, gophercloud.ErrAppCredMissingSecret{})
+}
+
+func TestCreateApplicationCredentialIgnoresScope(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "mysecret"}
+	scope := &tokens.Scope{ProjectID: "123456"}
+	authTokenPost(t, options, scope, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"id": "12345abcdef",
+						"secret": "mysecret"
+					},
+					"methods": [
+						"application_credential"
+					]
+				}
+			}
+		}
+	`)
+}
+
 func TestCreateProjectIDScope(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
 	scope := &tokens.Scope{ProjectID: "123456"}
@@ -376,6 +504,21 @@ func TestCreateFailureUserIDDomainName(t *testing.T) {
 	authTokenPostErr(t, options, nil, false, gophercloud.ErrDomainNameWithUserID{})
 }
 
+func TestCreateFailureAppCredMissingSecret(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialID: "12345abcdef"}
+	authTokenPostErr(t, options, nil, false, gophercloud.ErrAppCredMissingSecret{})
+}
+
+func TestCreateFailureAppCredNameMissingUser(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialName: "myappcred", ApplicationCredentialSecret: "mysecret"}
+	authTokenPostErr(t, options, nil, false, gophercloud.ErrUsernameOrUserID{})
+}
+
+func TestCreateFailureAppCredNameMissingDomain(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialName: "myappcred", ApplicationCredentialSecret: "mysecret", Username: "fenris"}
+	authTokenPostErr(t, options, nil, false, gophercloud.ErrDomainIDOrDomainName{})
+}
+
 func TestCreateFailureScopeProjectNameAlone(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
 	scope := &tokens.Scope{ProjectName: "notenough"}
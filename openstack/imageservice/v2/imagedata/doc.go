@@ -16,6 +16,23 @@ Example to Upload Image Data
 		panic(err)
 	}
 
+Example to Stage Image Data
+
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	imageData, err := os.Open("/path/to/image/file")
+	if err != nil {
+		panic(err)
+	}
+	defer imageData.Close()
+
+	err = imagedata.Stage(imageClient, imageID, imageData).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+The staged data is imported into the image with the imageimport package.
+
 Example to Download Image Data
 
 	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
@@ -16,10 +16,25 @@ func Upload(client *gophercloud.ServiceClient, id string, data io.Reader) (r Upl
 	return
 }
 
+// Stage performs PUT call on the existing image object in the Imageservice with
+// the provided file.
+// Existing image object must be in the "queued" status. The image is then in
+// the "uploading" status until it is imported with the imageimport package
+// using the glance-direct method.
+func Stage(client *gophercloud.ServiceClient, id string, data io.Reader) (r StageResult) {
+	_, r.Err = client.Put(stageURL(client, id), data, nil, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/octet-stream"},
+		OkCodes:     []int{204},
+	})
+	return
+}
+
 // Download retrieves an image.
 func Download(client *gophercloud.ServiceClient, id string) (r DownloadResult) {
 	var resp *http.Response
-	resp, r.Err = client.Get(downloadURL(client, id), nil, nil)
+	resp, r.Err = client.Get(downloadURL(client, id), nil, &gophercloud.RequestOpts{
+		KeepResponseBody: true,
+	})
 	if resp != nil {
 		r.Body = resp.Body
 		r.Header = resp.Header
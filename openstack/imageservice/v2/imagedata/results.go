@@ -13,6 +13,12 @@ type UploadResult struct {
 	gophercloud.ErrResult
 }
 
+// StageResult is the result of a stage image operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type StageResult struct {
+	gophercloud.ErrResult
+}
+
 // DownloadResult is the result of a download image operation. Call its Extract
 // method to gain access to the image data.
 type DownloadResult struct {
@@ -11,3 +11,7 @@ func uploadURL(c *gophercloud.ServiceClient, imageID string) string {
 func downloadURL(c *gophercloud.ServiceClient, imageID string) string {
 	return uploadURL(c, imageID)
 }
+
+func stageURL(c *gophercloud.ServiceClient, imageID string) string {
+	return c.ServiceURL("images", imageID, "stage")
+}
@@ -0,0 +1,93 @@
+package imageimport
+
+import "github.com/gophercloud/gophercloud"
+
+// ImportMethod represents valid Import API method.
+type ImportMethod string
+
+const (
+	// GlanceDirectMethod represents glance-direct Import API method. It
+	// imports the data previously staged with imagedata.Stage.
+	GlanceDirectMethod ImportMethod = "glance-direct"
+
+	// WebDownloadMethod represents web-download Import API method. It imports
+	// the data from the URI of the CreateOpts.
+	WebDownloadMethod ImportMethod = "web-download"
+
+	// CopyImageMethod represents copy-image Import API method. It copies the
+	// data of an active image to the Stores of the CreateOpts.
+	CopyImageMethod ImportMethod = "copy-image"
+)
+
+// Get retrieves Import API information data.
+func Get(c *gophercloud.ServiceClient) (r GetResult) {
+	_, r.Err = c.Get(infoURL(c), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToImportCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new image import.
+type CreateOpts struct {
+	// Name is the import method to use.
+	Name ImportMethod `json:"name" required:"true"`
+
+	// URI is the location of the image data. It is required by the
+	// web-download method.
+	URI string `json:"uri,omitempty"`
+
+	// Stores is the list of stores the image data is imported to.
+	Stores []string `json:"-"`
+
+	// AllStores requests the image data to be imported to all stores.
+	AllStores *bool `json:"-"`
+
+	// AllStoresMustSucceed controls whether the import fails if the image
+	// data can't be imported to one of the stores.
+	AllStoresMustSucceed *bool `json:"-"`
+}
+
+// ToImportCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToImportCreateMap() (map[string]interface{}, error) {
+	if opts.Name == WebDownloadMethod && opts.URI == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "imageimport.CreateOpts.URI"
+		return nil, err
+	}
+
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	r := map[string]interface{}{"method": b}
+	if len(opts.Stores) > 0 {
+		r["stores"] = opts.Stores
+	}
+	if opts.AllStores != nil {
+		r["all_stores"] = *opts.AllStores
+	}
+	if opts.AllStoresMustSucceed != nil {
+		r["all_stores_must_succeed"] = *opts.AllStoresMustSucceed
+	}
+
+	return r, nil
+}
+
+// Create requests the import of the data of an image with the method of the
+// CreateOpts. The import is performed asynchronously; its progress can be
+// observed with the tasks package.
+func Create(client *gophercloud.ServiceClient, imageID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToImportCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(importURL(client, imageID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,2 @@
+// imageimport unit tests
+package testing
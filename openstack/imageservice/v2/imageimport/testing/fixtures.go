@@ -0,0 +1,37 @@
+package testing
+
+// ImportGetResult represents raw server response on a GET request.
+const ImportGetResult = `
+{
+    "import-methods": {
+        "description": "Import methods available.",
+        "type": "array",
+        "value": [
+            "glance-direct",
+            "web-download",
+            "copy-image"
+        ]
+    }
+}
+`
+
+// ImportCreateRequest represents a request to create image import.
+const ImportCreateRequest = `
+{
+    "method": {
+        "name": "web-download",
+        "uri": "http://download.cirros-cloud.net/0.4.0/cirros-0.4.0-x86_64-disk.img"
+    }
+}
+`
+
+// ImportCopyRequest represents a request to copy an image to other stores.
+const ImportCopyRequest = `
+{
+    "method": {
+        "name": "copy-image"
+    },
+    "stores": ["ceph", "swift"],
+    "all_stores_must_succeed": false
+}
+`
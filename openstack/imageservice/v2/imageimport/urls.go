@@ -0,0 +1,16 @@
+package imageimport
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	infoPath     = "info"
+	resourcePath = "import"
+)
+
+func infoURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(infoPath, resourcePath)
+}
+
+func importURL(c *gophercloud.ServiceClient, imageID string) string {
+	return c.ServiceURL("images", imageID, resourcePath)
+}
@@ -17,6 +17,14 @@ const (
 	// uploaded to Glance
 	ImageStatusSaving ImageStatus = "saving"
 
+	// ImageStatusUploading denotes that an image's raw data has been staged
+	// and is waiting to be imported.
+	ImageStatusUploading ImageStatus = "uploading"
+
+	// ImageStatusImporting denotes that an image's data is currently being
+	// imported.
+	ImageStatusImporting ImageStatus = "importing"
+
 	// ImageStatusActive denotes an image that is fully available in Glance.
 	ImageStatusActive ImageStatus = "active"
 
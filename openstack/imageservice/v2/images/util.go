@@ -0,0 +1,29 @@
+package images
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// NewWaiter returns a Waiter which polls an image until its status is one of
+// target, such as ImageStatusActive. A killed image is a failure. The Wait
+// method of the Waiter returns the image as an *Image.
+func NewWaiter(c *gophercloud.ServiceClient, id string, target ...ImageStatus) *gophercloud.Waiter {
+	states := make([]string, len(target))
+	for i, s := range target {
+		states[i] = string(s)
+	}
+
+	return &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			image, err := Get(c.WithContext(ctx), id).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return image, string(image.Status), nil
+		},
+		Target:  states,
+		Failure: []string{string(ImageStatusKilled)},
+	}
+}
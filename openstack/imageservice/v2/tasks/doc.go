@@ -0,0 +1,80 @@
+/*
+Package tasks enables management and retrieval of tasks from the OpenStack
+Imageservice. Tasks perform the asynchronous import of image data, and can be
+used to observe the progress of an import requested with the imageimport
+package.
+
+Example to List Tasks
+
+	listOpts := tasks.ListOpts{
+		Status: tasks.TaskStatusProcessing,
+	}
+
+	allPages, err := tasks.List(imagesClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTasks, err := tasks.ExtractTasks(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, task := range allTasks {
+		fmt.Printf("%+v\n", task)
+	}
+
+Example to Get a Task
+
+	task, err := tasks.Get(imagesClient, "1252f636-1246-4319-bfba-c47cde0efbe0").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", task)
+
+Example to Create a Task
+
+	createOpts := tasks.CreateOpts{
+		Type: "import",
+		Input: map[string]interface{}{
+			"image_properties": map[string]interface{}{
+				"container_format": "bare",
+				"disk_format":      "raw",
+			},
+			"import_from_format": "raw",
+			"import_from":        "https://cloud-images.ubuntu.com/bionic/current/bionic-server-cloudimg-amd64.img",
+		},
+	}
+
+	task, err := tasks.Create(imagesClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	waiter := tasks.NewWaiter(imagesClient, task.ID)
+	waiter.Timeout = 30 * time.Minute
+	v, err := waiter.Wait(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(v.(*tasks.Task).Result["image_id"])
+
+Example to List the Tasks of an Image Import
+
+	allPages, err := tasks.ListImageTasks(imagesClient, imageID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	imageTasks, err := tasks.ExtractImageTasks(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, task := range imageTasks {
+		fmt.Println(task.ID, task.Status, task.Message)
+	}
+*/
+package tasks
@@ -0,0 +1,148 @@
+package tasks
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Task represents a single task of the OpenStack Imageservice.
+type Task struct {
+	// ID is a unique identifier of the task.
+	ID string `json:"id"`
+
+	// Type represents the type of the task. The only type of task is
+	// "import".
+	Type string `json:"type"`
+
+	// Status represents current status of the task.
+	// You can use the TaskStatus custom type to unmarshal raw JSON response
+	// into the pre-defined valid task status.
+	Status string `json:"status"`
+
+	// Input represents different parameters for the task. It is not returned
+	// by List.
+	Input map[string]interface{} `json:"input"`
+
+	// Result represents the result of the task. It is not returned by List.
+	Result map[string]interface{} `json:"result"`
+
+	// Owner is a unique identifier of the task owner.
+	Owner string `json:"owner"`
+
+	// Message represents human-readable message that is usually populated
+	// on task failure.
+	Message string `json:"message"`
+
+	// ExpiresAt contains the timestamp of when the task will become a subject
+	// of removal.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// CreatedAt is a timestamp of when the task was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is a timestamp of when the task was updated.
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Self contains URI for the task.
+	Self string `json:"self"`
+
+	// Schema the path to the JSON-schema that represent the task.
+	Schema string `json:"schema"`
+
+	// ImageID is the ID of the image the task imported. It is only returned
+	// by ListImageTasks.
+	ImageID string `json:"image_id"`
+
+	// RequestID is the ID of the request which created the task. It is only
+	// returned by ListImageTasks.
+	RequestID string `json:"request_id"`
+
+	// UserID is the ID of the user who created the task. It is only returned
+	// by ListImageTasks.
+	UserID string `json:"user_id"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Task.
+func (r commonResult) Extract() (*Task, error) {
+	var s *Task
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a Task.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a Create operation. Call its Extract
+// method to interpret it as a Task.
+type CreateResult struct {
+	commonResult
+}
+
+// TaskPage represents the results of a List request.
+type TaskPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a TaskPage contains no Tasks results.
+func (r TaskPage) IsEmpty() (bool, error) {
+	tasks, err := ExtractTasks(r)
+	return len(tasks) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to
+// the next page of results.
+func (r TaskPage) NextPageURL() (string, error) {
+	var s struct {
+		Next string `json:"next"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	if s.Next == "" {
+		return "", nil
+	}
+
+	return nextPageURL(r.URL.String(), s.Next)
+}
+
+// ExtractTasks interprets the results of a single page from a List() call,
+// producing a slice of Task entities.
+func ExtractTasks(r pagination.Page) ([]Task, error) {
+	var s struct {
+		Tasks []Task `json:"tasks"`
+	}
+	err := (r.(TaskPage)).ExtractInto(&s)
+	return s.Tasks, err
+}
+
+// ImageTaskPage represents the results of a ListImageTasks request.
+type ImageTaskPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty returns true if an ImageTaskPage contains no Tasks results.
+func (r ImageTaskPage) IsEmpty() (bool, error) {
+	tasks, err := ExtractImageTasks(r)
+	return len(tasks) == 0, err
+}
+
+// ExtractImageTasks interprets the results of a ListImageTasks() call,
+// producing a slice of Task entities.
+func ExtractImageTasks(r pagination.Page) ([]Task, error) {
+	var s struct {
+		Tasks []Task `json:"tasks"`
+	}
+	err := (r.(ImageTaskPage)).ExtractInto(&s)
+	return s.Tasks, err
+}
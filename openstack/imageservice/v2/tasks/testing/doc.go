@@ -0,0 +1,2 @@
+// tasks unit tests
+package testing
@@ -0,0 +1,140 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/tasks"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"limit":    "2",
+			"sort_dir": "desc",
+			"sort_key": "status",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, TasksListResult)
+	})
+
+	count := 0
+
+	err := tasks.List(fakeclient.ServiceClient(), tasks.ListOpts{
+		Limit:   2,
+		SortDir: "desc",
+		SortKey: "status",
+	}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := tasks.ExtractTasks(page)
+		th.AssertNoErr(t, err)
+
+		th.AssertDeepEquals(t, []tasks.Task{Task1, Task2}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/tasks/1252f636-1246-4319-bfba-c47cde0efbe0", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, TasksGetResult)
+	})
+
+	s, err := tasks.Get(fakeclient.ServiceClient(), "1252f636-1246-4319-bfba-c47cde0efbe0").Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, string(tasks.TaskStatusSuccess), s.Status)
+	th.AssertEquals(t, time.Date(2018, 7, 25, 8, 59, 13, 0, time.UTC), s.CreatedAt)
+	th.AssertEquals(t, time.Date(2018, 7, 27, 9, 0, 2, 0, time.UTC), s.ExpiresAt)
+	th.AssertEquals(t, "1a8ec7d2-4f5a-4f44-8e1a-2f4f58e6b7f1", s.Result["image_id"])
+	th.AssertEquals(t, "raw", s.Input["import_from_format"])
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestJSONRequest(t, r, TaskCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, TaskCreateResult)
+	})
+
+	opts := tasks.CreateOpts{
+		Type: "import",
+		Input: map[string]interface{}{
+			"image_properties": map[string]interface{}{
+				"container_format": "bare",
+				"disk_format":      "raw",
+			},
+			"import_from_format": "raw",
+			"import_from":        "http://download.cirros-cloud.net/0.4.0/cirros-0.4.0-x86_64-disk.img",
+		},
+	}
+	s, err := tasks.Create(fakeclient.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "d550c87d-86ed-430a-9895-c7a1f5ce87e9", s.ID)
+	th.AssertEquals(t, string(tasks.TaskStatusPending), s.Status)
+	th.AssertEquals(t, true, s.ExpiresAt.IsZero())
+	th.AssertDeepEquals(t, opts.Input, s.Input)
+}
+
+func TestListImageTasks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images/dddddddd-dddd-dddd-dddd-dddddddddddd/tasks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ImageTasksListResult)
+	})
+
+	allPages, err := tasks.ListImageTasks(fakeclient.ServiceClient(), "dddddddd-dddd-dddd-dddd-dddddddddddd").AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := tasks.ExtractImageTasks(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "dddddddd-dddd-dddd-dddd-dddddddddddd", actual[0].ImageID)
+	th.AssertEquals(t, "rrrrrrr-rrrr-rrrr-rrrr-rrrrrrrrrrrr", actual[0].RequestID)
+	th.AssertEquals(t, "uuuuuuuu-uuuu-uuuu-uuuu-uuuuuuuuuuuu", actual[0].UserID)
+	th.AssertEquals(t, string(tasks.TaskStatusProcessing), actual[0].Status)
+}
@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+const resourcePath = "tasks"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, taskID string) string {
+	return c.ServiceURL(resourcePath, taskID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, taskID string) string {
+	return resourceURL(c, taskID)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func listImageTasksURL(c *gophercloud.ServiceClient, imageID string) string {
+	return c.ServiceURL("images", imageID, resourcePath)
+}
+
+// builds next page full url based on current url
+func nextPageURL(currentURL string, next string) (string, error) {
+	base, err := url.Parse(currentURL)
+	if err != nil {
+		return "", err
+	}
+	rel, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	return base.ResolveReference(rel).String(), nil
+}
@@ -0,0 +1,24 @@
+package tasks
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// NewWaiter returns a Waiter which polls a task until its status is
+// TaskStatusSuccess. A task whose status is TaskStatusFailure is a failure.
+// The Wait method of the Waiter returns the task as a *Task.
+func NewWaiter(c *gophercloud.ServiceClient, id string) *gophercloud.Waiter {
+	return &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			task, err := Get(c.WithContext(ctx), id).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return task, task.Status, nil
+		},
+		Target:  []string{string(TaskStatusSuccess)},
+		Failure: []string{string(TaskStatusFailure)},
+	}
+}
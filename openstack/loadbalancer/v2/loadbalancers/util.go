@@ -0,0 +1,25 @@
+package loadbalancers
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// NewWaiter returns a Waiter which polls a load balancer until its
+// provisioning status is one of target, usually ACTIVE. A load balancer in
+// ERROR is a failure. The Wait method of the Waiter returns the load balancer
+// as a *LoadBalancer.
+func NewWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.Waiter {
+	return &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			lb, err := Get(c.WithContext(ctx), id).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return lb, lb.ProvisioningStatus, nil
+		},
+		Target:  target,
+		Failure: []string{"ERROR"},
+	}
+}
@@ -0,0 +1,209 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/agents"
+)
+
+// AgentsListResult represents raw response for the List request.
+const AgentsListResult = `
+{
+    "agents": [
+        {
+            "admin_state_up": true,
+            "agent_type": "Open vSwitch agent",
+            "alive": true,
+            "availability_zone": null,
+            "binary": "neutron-openvswitch-agent",
+            "configurations": {
+                "datapath_type": "system",
+                "extensions": [
+                    "qos"
+                ]
+            },
+            "created_at": "2017-07-26 23:15:44",
+            "description": null,
+            "heartbeat_timestamp": "2019-01-09 10:28:53",
+            "host": "compute1",
+            "id": "59186d7b-b512-4fdf-bbaf-5804ffde8811",
+            "started_at": "2018-06-26 21:46:19",
+            "topic": "N/A"
+        },
+        {
+            "admin_state_up": true,
+            "agent_type": "DHCP agent",
+            "alive": true,
+            "availability_zone": "nova",
+            "binary": "neutron-dhcp-agent",
+            "configurations": {
+                "dhcp_driver": "neutron.agent.linux.dhcp.Dnsmasq",
+                "networks": 3
+            },
+            "created_at": "2017-06-26 21:46:19",
+            "description": null,
+            "heartbeat_timestamp": "2019-01-09 11:06:53",
+            "host": "compute2",
+            "id": "76af7b1f-d61b-4526-94f7-d2e14e2698df",
+            "started_at": "2018-06-26 21:46:20",
+            "topic": "dhcp_agent"
+        }
+    ]
+}
+`
+
+// Agent1 represents first unmarshalled agent from the
+// AgentsListResult.
+var Agent1 = agents.Agent{
+	ID:           "59186d7b-b512-4fdf-bbaf-5804ffde8811",
+	AdminStateUp: true,
+	AgentType:    "Open vSwitch agent",
+	Alive:        true,
+	Binary:       "neutron-openvswitch-agent",
+	Configurations: map[string]interface{}{
+		"datapath_type": "system",
+		"extensions": []interface{}{
+			"qos",
+		},
+	},
+	CreatedAt:          time.Date(2017, 7, 26, 23, 15, 44, 0, time.UTC),
+	StartedAt:          time.Date(2018, 6, 26, 21, 46, 19, 0, time.UTC),
+	HeartbeatTimestamp: time.Date(2019, 1, 9, 10, 28, 53, 0, time.UTC),
+	Host:               "compute1",
+	Topic:              "N/A",
+}
+
+// Agent2 represents second unmarshalled agent from the
+// AgentsListResult.
+var Agent2 = agents.Agent{
+	ID:               "76af7b1f-d61b-4526-94f7-d2e14e2698df",
+	AdminStateUp:     true,
+	AgentType:        "DHCP agent",
+	Alive:            true,
+	AvailabilityZone: "nova",
+	Binary:           "neutron-dhcp-agent",
+	Configurations: map[string]interface{}{
+		"dhcp_driver": "neutron.agent.linux.dhcp.Dnsmasq",
+		"networks":    float64(3),
+	},
+	CreatedAt:          time.Date(2017, 6, 26, 21, 46, 19, 0, time.UTC),
+	StartedAt:          time.Date(2018, 6, 26, 21, 46, 20, 0, time.UTC),
+	HeartbeatTimestamp: time.Date(2019, 1, 9, 11, 6, 53, 0, time.UTC),
+	Host:               "compute2",
+	Topic:              "dhcp_agent",
+}
+
+// AgentsGetResult represents raw response for the Get request.
+const AgentsGetResult = `
+{
+    "agent": {
+        "binary": "neutron-openvswitch-agent",
+        "description": null,
+        "availability_zone": null,
+        "heartbeat_timestamp": "2019-01-09 11:43:01",
+        "admin_state_up": true,
+        "alive": true,
+        "id": "43583cf5-472e-4dc8-af5b-6aed4c94ee3a",
+        "topic": "N/A",
+        "host": "compute3",
+        "agent_type": "Open vSwitch agent",
+        "started_at": "2018-06-26 21:46:20",
+        "created_at": "2017-07-26 23:02:05",
+        "configurations": {
+            "ovs_hybrid_plug": false,
+            "datapath_type": "system",
+            "vhostuser_socket_dir": "/var/run/openvswitch",
+            "log_agent_heartbeats": false,
+            "l2_population": true,
+            "enable_distributed_routing": false
+        }
+    }
+}
+`
+
+// AgentUpdateRequest represents raw request to update an Agent.
+const AgentUpdateRequest = `
+{
+    "agent": {
+        "description": "My OVS agent for OpenStack",
+        "admin_state_up": true
+    }
+}
+`
+
+// AgentUpdateResult represents raw response for the Update request.
+const AgentUpdateResult = `
+{
+    "agent": {
+        "binary": "neutron-openvswitch-agent",
+        "description": "My OVS agent for OpenStack",
+        "availability_zone": null,
+        "heartbeat_timestamp": "2019-01-09 11:43:01",
+        "admin_state_up": true,
+        "alive": true,
+        "id": "43583cf5-472e-4dc8-af5b-6aed4c94ee3a",
+        "topic": "N/A",
+        "host": "compute3",
+        "agent_type": "Open vSwitch agent",
+        "started_at": "2018-06-26 21:46:20",
+        "created_at": "2017-07-26 23:02:05",
+        "configurations": {
+            "datapath_type": "system"
+        }
+    }
+}
+`
+
+// AgentDHCPNetworksListResult represents raw response for the
+// ListDHCPNetworks request.
+const AgentDHCPNetworksListResult = `
+{
+    "networks": [
+        {
+            "admin_state_up": true,
+            "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "name": "net1",
+            "shared": false,
+            "status": "ACTIVE",
+            "subnets": [
+                "54d6f61d-db07-451c-9ab3-b9609b6b6f0b"
+            ],
+            "tenant_id": "4fd44f30292945e481c7b8a0c8908869"
+        }
+    ]
+}
+`
+
+// ScheduleDHCPNetworkRequest represents raw request for the
+// ScheduleDHCPNetwork request.
+const ScheduleDHCPNetworkRequest = `
+{
+    "network_id": "1ae075ca-708b-4e66-b4a7-b7698632f05f"
+}
+`
+
+// AgentL3RoutersListResult represents raw response for the ListL3Routers
+// request.
+const AgentL3RoutersListResult = `
+{
+    "routers": [
+        {
+            "admin_state_up": true,
+            "distributed": false,
+            "external_gateway_info": null,
+            "id": "9b8a7ec4-ba40-4a4a-8a7f-e7c5e1b1ac7c",
+            "name": "router1",
+            "status": "ACTIVE",
+            "tenant_id": "4fd44f30292945e481c7b8a0c8908869"
+        }
+    ]
+}
+`
+
+// ScheduleL3RouterRequest represents raw request for the ScheduleL3Router
+// request.
+const ScheduleL3RouterRequest = `
+{
+    "router_id": "9b8a7ec4-ba40-4a4a-8a7f-e7c5e1b1ac7c"
+}
+`
@@ -0,0 +1,63 @@
+package agents
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "agents"
+const dhcpNetworksResourcePath = "dhcp-networks"
+const l3RoutersResourcePath = "l3-routers"
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func dhcpNetworksURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, dhcpNetworksResourcePath)
+}
+
+func listDHCPNetworksURL(c *gophercloud.ServiceClient, id string) string {
+	return dhcpNetworksURL(c, id)
+}
+
+func scheduleDHCPNetworkURL(c *gophercloud.ServiceClient, id string) string {
+	return dhcpNetworksURL(c, id)
+}
+
+func removeDHCPNetworkURL(c *gophercloud.ServiceClient, id string, networkID string) string {
+	return c.ServiceURL(resourcePath, id, dhcpNetworksResourcePath, networkID)
+}
+
+func l3RoutersURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, l3RoutersResourcePath)
+}
+
+func listL3RoutersURL(c *gophercloud.ServiceClient, id string) string {
+	return l3RoutersURL(c, id)
+}
+
+func scheduleL3RouterURL(c *gophercloud.ServiceClient, id string) string {
+	return l3RoutersURL(c, id)
+}
+
+func removeL3RouterURL(c *gophercloud.ServiceClient, id string, routerID string) string {
+	return c.ServiceURL(resourcePath, id, l3RoutersResourcePath, routerID)
+}
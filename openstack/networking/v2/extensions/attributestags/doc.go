@@ -0,0 +1,55 @@
+/*
+Package attributestags manages Tags on Resources created by the OpenStack Neutron Service.
+
+This enables tagging via a standard interface for resources types which support it.
+The resource type is the collection name of the resource, such as "networks",
+"subnets", "ports", "routers", "floatingips", "security-groups", "subnetpools",
+"trunks" or "policies".
+
+To filter resources by their tags, use the Tags, TagsAny, NotTags and
+NotTagsAny fields of the resource's ListOpts. Each of them takes a
+comma-separated list of tags.
+
+See https://developer.openstack.org/api-ref/network/v2/#standard-attributes-tag-extension for more information on the underlying API.
+
+Example to ReplaceAll Resource Tags
+
+	network, err := networks.Create(conn, createOpts).Extract()
+
+	tagReplaceAllOpts := attributestags.ReplaceAllOpts{
+		Tags: []string{"abc", "123"},
+	}
+	attributestags.ReplaceAll(conn, "networks", network.ID, tagReplaceAllOpts)
+
+Example to List all Resource Tags
+
+	tags, err = attributestags.List(conn, "networks", network.ID).Extract()
+
+Example to Delete all Resource Tags
+
+	err = attributestags.DeleteAll(conn, "networks", network.ID).ExtractErr()
+
+Example to Add a tag to a Resource
+
+	err = attributestags.Add(client, "networks", network.ID, "atag").ExtractErr()
+
+Example to Delete a tag from a Resource
+
+	err = attributestags.Delete(client, "networks", network.ID, "atag").ExtractErr()
+
+Example to confirm if a tag exists on a resource
+
+	exists, _ := attributestags.Check(client, "networks", network.ID, "atag").Extract()
+
+Example to List Networks with any of the given tags
+
+	listOpts := networks.ListOpts{
+		TagsAny: "abc,123",
+	}
+
+	allPages, err := networks.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+*/
+package attributestags
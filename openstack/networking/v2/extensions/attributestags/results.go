@@ -0,0 +1,62 @@
+package attributestags
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+type tagResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets tagResult to return the list of tags
+func (r tagResult) Extract() ([]string, error) {
+	var s struct {
+		Tags []string `json:"tags"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Tags, err
+}
+
+// ReplaceAllResult represents the result of a replace operation.
+// Call its Extract method to interpret it as a slice of strings.
+type ReplaceAllResult struct {
+	tagResult
+}
+
+// ListResult represents the result of a list operation. Call its Extract
+// method to interpret it as a slice of strings.
+type ListResult struct {
+	tagResult
+}
+
+// DeleteResult is the result from a Delete/DeleteAll operation.
+// Call its ExtractErr method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AddResult is the result from an Add operation.
+// Call its ExtractErr method to determine if the call succeeded or failed.
+type AddResult struct {
+	gophercloud.ErrResult
+}
+
+// CheckResult is the result from a Check operation.
+// Call its Extract method to determine if the tag exists.
+type CheckResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract interprets a CheckResult as a bool. A 404 response is not treated
+// as an error, but reported as the tag not existing.
+func (r CheckResult) Extract() (bool, error) {
+	exists := r.Err == nil
+
+	if r.Err != nil {
+		if _, ok := r.Err.(gophercloud.ErrDefault404); ok {
+			r.Err = nil
+		}
+	}
+
+	return exists, r.Err
+}
@@ -0,0 +1,2 @@
+// attributestags unit tests
+package testing
@@ -0,0 +1,31 @@
+package attributestags
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	tagsPath = "tags"
+)
+
+func replaceURL(c *gophercloud.ServiceClient, resourceType string, id string) string {
+	return c.ServiceURL(resourceType, id, tagsPath)
+}
+
+func listURL(c *gophercloud.ServiceClient, resourceType string, id string) string {
+	return c.ServiceURL(resourceType, id, tagsPath)
+}
+
+func deleteAllURL(c *gophercloud.ServiceClient, resourceType string, id string) string {
+	return c.ServiceURL(resourceType, id, tagsPath)
+}
+
+func addURL(c *gophercloud.ServiceClient, resourceType string, id string, tag string) string {
+	return c.ServiceURL(resourceType, id, tagsPath, tag)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, resourceType string, id string, tag string) string {
+	return c.ServiceURL(resourceType, id, tagsPath, tag)
+}
+
+func confirmURL(c *gophercloud.ServiceClient, resourceType string, id string, tag string) string {
+	return c.ServiceURL(resourceType, id, tagsPath, tag)
+}
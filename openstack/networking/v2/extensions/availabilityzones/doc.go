@@ -0,0 +1,26 @@
+/*
+Package availabilityzones provides the ability to list the availability zones
+of the network agents through the Neutron API.
+
+Example of Listing Availability Zones
+
+	listOpts := availabilityzones.ListOpts{
+		Resource: "router",
+		State:    "available",
+	}
+
+	allPages, err := availabilityzones.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAvailabilityZones, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, zone := range allAvailabilityZones {
+		fmt.Printf("%+v\n", zone)
+	}
+*/
+package availabilityzones
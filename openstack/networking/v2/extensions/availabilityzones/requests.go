@@ -0,0 +1,49 @@
+package availabilityzones
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAvailabilityZoneListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of the availability zones returned by the
+// API. Filtering is achieved by passing in struct field values that map to
+// the availability zone attributes you want to see returned.
+type ListOpts struct {
+	// Name is the name of the availability zone.
+	Name string `q:"name"`
+
+	// Resource is the type of resource the availability zone is for, such as
+	// "network" or "router".
+	Resource string `q:"resource"`
+
+	// State is the state of the availability zone, either "available" or
+	// "unavailable".
+	State string `q:"state"`
+}
+
+// ToAvailabilityZoneListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAvailabilityZoneListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List will return the existing availability zones.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToAvailabilityZoneListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AvailabilityZonePage{pagination.SinglePageBase(r)}
+	})
+}
@@ -0,0 +1,41 @@
+package availabilityzones
+
+import (
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// AvailabilityZone represents an availability zone of network agents.
+type AvailabilityZone struct {
+	// Name is the name of the availability zone.
+	Name string `json:"name"`
+
+	// Resource is the type of resource the availability zone is for, such as
+	// "network" or "router".
+	Resource string `json:"resource"`
+
+	// State is the state of the availability zone.
+	State string `json:"state"`
+}
+
+// AvailabilityZonePage stores a single page of AvailabilityZones from a List()
+// API call.
+type AvailabilityZonePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a AvailabilityZonePage is empty.
+func (r AvailabilityZonePage) IsEmpty() (bool, error) {
+	availabilityZones, err := ExtractAvailabilityZones(r)
+	return len(availabilityZones) == 0, err
+}
+
+// ExtractAvailabilityZones accepts a Page struct, specifically a
+// AvailabilityZonePage struct, and extracts the elements into a slice of
+// AvailabilityZone structs.
+func ExtractAvailabilityZones(r pagination.Page) ([]AvailabilityZone, error) {
+	var s struct {
+		AvailabilityZones []AvailabilityZone `json:"availability_zones"`
+	}
+	err := (r.(AvailabilityZonePage)).ExtractInto(&s)
+	return s.AvailabilityZones, err
+}
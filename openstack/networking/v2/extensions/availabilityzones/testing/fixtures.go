@@ -0,0 +1,37 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/availabilityzones"
+)
+
+// AvailabilityZoneListResult represents raw response for the List request.
+const AvailabilityZoneListResult = `
+{
+    "availability_zones": [
+        {
+            "state": "available",
+            "resource": "network",
+            "name": "nova"
+        },
+        {
+            "state": "available",
+            "resource": "router",
+            "name": "nova"
+        }
+    ]
+}
+`
+
+// ExpectedAvailabilityZones is the expected result of the List request.
+var ExpectedAvailabilityZones = []availabilityzones.AvailabilityZone{
+	{
+		Name:     "nova",
+		Resource: "network",
+		State:    "available",
+	},
+	{
+		Name:     "nova",
+		Resource: "router",
+		State:    "available",
+	},
+}
@@ -0,0 +1,46 @@
+/*
+Package extraroutes enables the atomic addition and removal of extra routes
+of a Router through the OpenStack Networking service.
+
+Unlike updating the Routes of a Router with routers.Update, which replaces
+the whole list, these requests only affect the given routes.
+
+Example to Add Extra Routes
+
+	routerID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+	routes := []routers.Route{
+		{
+			DestinationCIDR: "192.168.3.0/24",
+			NextHop:         "172.16.0.2",
+		},
+	}
+
+	addExtraRoutesOpts := extraroutes.Opts{
+		Routes: routes,
+	}
+
+	router, err := extraroutes.Add(networkClient, routerID, addExtraRoutesOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove Extra Routes
+
+	routerID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+	routes := []routers.Route{
+		{
+			DestinationCIDR: "192.168.3.0/24",
+			NextHop:         "172.16.0.2",
+		},
+	}
+
+	removeExtraRoutesOpts := extraroutes.Opts{
+		Routes: routes,
+	}
+
+	router, err := extraroutes.Remove(networkClient, routerID, removeExtraRoutesOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package extraroutes
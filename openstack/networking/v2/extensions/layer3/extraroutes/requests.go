@@ -0,0 +1,51 @@
+package extraroutes
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+)
+
+// OptsBuilder allows extensions to add additional parameters to the Add and
+// Remove requests.
+type OptsBuilder interface {
+	ToExtraRoutesUpdateMap() (map[string]interface{}, error)
+}
+
+// Opts contains the values used when adding or removing extra routes.
+type Opts struct {
+	// Routes is the list of routes to add to or remove from the router.
+	Routes []routers.Route `json:"routes" required:"true"`
+}
+
+// ToExtraRoutesUpdateMap builds a body based on Opts.
+func (opts Opts) ToExtraRoutesUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "router")
+}
+
+// Add atomically adds extra routes to a router, leaving the routes already
+// present on the router untouched.
+func Add(c *gophercloud.ServiceClient, id string, opts OptsBuilder) (r AddResult) {
+	b, err := opts.ToExtraRoutesUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(addExtraRoutesURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Remove atomically removes extra routes from a router, leaving the other
+// routes of the router untouched.
+func Remove(c *gophercloud.ServiceClient, id string, opts OptsBuilder) (r RemoveResult) {
+	b, err := opts.ToExtraRoutesUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(removeExtraRoutesURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
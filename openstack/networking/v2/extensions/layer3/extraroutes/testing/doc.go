@@ -0,0 +1,2 @@
+// extraroutes unit tests
+package testing
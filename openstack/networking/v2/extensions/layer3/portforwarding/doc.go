@@ -0,0 +1,74 @@
+/*
+Package portforwarding enables management and retrieval of port forwarding
+resources of floating IPs through the OpenStack Networking service. A port
+forwarding allows a single floating IP to front several internal services by
+forwarding traffic arriving on one of its ports to an internal IP address and
+port.
+
+Example to List all Port Forwardings for a floating IP
+
+	fipID := "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e"
+	allPages, err := portforwarding.List(networkClient, fipID, portforwarding.ListOpts{}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allPFs, err := portforwarding.ExtractPortForwardings(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, pf := range allPFs {
+		fmt.Printf("%+v\n", pf)
+	}
+
+Example to Get a Port Forwarding with a certain ID
+
+	fipID := "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e"
+	pfID := "725ade3c-9760-4880-8080-8fc2dbab9acc"
+	pf, err := portforwarding.Get(networkClient, fipID, pfID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Port Forwarding for a floating IP
+
+	createOpts := &portforwarding.CreateOpts{
+		Protocol:          "tcp",
+		InternalPort:      25,
+		ExternalPort:      2230,
+		InternalIPAddress: "10.0.0.11",
+		InternalPortID:    "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+	}
+
+	fipID := "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e"
+	pf, err := portforwarding.Create(networkClient, fipID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Port Forwarding
+
+	updateOpts := portforwarding.UpdateOpts{
+		Protocol:     "udp",
+		InternalPort: 30,
+		ExternalPort: 678,
+	}
+
+	fipID := "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e"
+	pfID := "725ade3c-9760-4880-8080-8fc2dbab9acc"
+	pf, err := portforwarding.Update(networkClient, fipID, pfID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Port Forwarding
+
+	fipID := "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e"
+	pfID := "725ade3c-9760-4880-8080-8fc2dbab9acc"
+	err := portforwarding.Delete(networkClient, fipID, pfID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package portforwarding
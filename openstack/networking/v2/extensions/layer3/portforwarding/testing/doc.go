@@ -0,0 +1,2 @@
+// portforwarding unit tests
+package testing
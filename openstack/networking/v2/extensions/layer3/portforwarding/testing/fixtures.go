@@ -0,0 +1,104 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+const ListResponse = `
+{
+    "port_forwardings": [
+        {
+            "id": "725ade3c-9760-4880-8080-8fc2dbab9acc",
+            "internal_port_id": "070ef0b2-0175-4299-be5c-01fea8cca522",
+            "internal_ip_address": "10.0.0.24",
+            "internal_port": 25,
+            "external_port": 2229,
+            "protocol": "tcp",
+            "description": "smtp"
+        },
+        {
+            "id": "725ade3c-9760-4880-8080-8fc2dbab9acd",
+            "internal_port_id": "070ef0b2-0175-4299-be5c-01fea8cca523",
+            "internal_ip_address": "10.0.0.25",
+            "internal_port": 80,
+            "external_port": 2230,
+            "protocol": "tcp",
+            "description": ""
+        }
+    ]
+}
+`
+
+const CreateRequest = `
+{
+    "port_forwarding": {
+        "protocol": "tcp",
+        "internal_ip_address": "10.0.0.11",
+        "internal_port": 25,
+        "internal_port_id": "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+        "external_port": 2230,
+        "description": "smtp"
+    }
+}
+`
+
+const PortForwardingResponse = `
+{
+    "port_forwarding": {
+        "protocol": "tcp",
+        "internal_ip_address": "10.0.0.11",
+        "internal_port": 25,
+        "internal_port_id": "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+        "external_port": 2230,
+        "id": "725ade3c-9760-4880-8080-8fc2dbab9acc",
+        "description": "smtp"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "port_forwarding": {
+        "protocol": "udp",
+        "internal_port": 37,
+        "internal_port_id": "99889dc2-19a7-4edb-b9d0-d2ace8d1e144",
+        "external_port": 1960
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "port_forwarding": {
+        "protocol": "udp",
+        "internal_ip_address": "10.0.0.14",
+        "internal_port": 37,
+        "internal_port_id": "99889dc2-19a7-4edb-b9d0-d2ace8d1e144",
+        "external_port": 1960,
+        "id": "725ade3c-9760-4880-8080-8fc2dbab9acc",
+        "description": "smtp"
+    }
+}
+`
+
+// HandlePortForwardingCreationSuccessfully sets up the test server to respond
+// to a port forwarding creation request with the given response.
+func HandlePortForwardingCreationSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/v2.0/floatingips/2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e/port_forwardings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, response)
+	})
+}
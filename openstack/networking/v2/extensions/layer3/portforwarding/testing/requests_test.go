@@ -0,0 +1,181 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestPortForwardingList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e/port_forwardings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"protocol": "tcp"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	count := 0
+
+	portforwarding.List(fake.ServiceClient(), "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e", portforwarding.ListOpts{Protocol: "tcp"}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := portforwarding.ExtractPortForwardings(page)
+		if err != nil {
+			t.Errorf("Failed to extract port forwardings: %v", err)
+			return false, err
+		}
+
+		expected := []portforwarding.PortForwarding{
+			{
+				Protocol:          "tcp",
+				InternalPortID:    "070ef0b2-0175-4299-be5c-01fea8cca522",
+				InternalIPAddress: "10.0.0.24",
+				InternalPort:      25,
+				ID:                "725ade3c-9760-4880-8080-8fc2dbab9acc",
+				ExternalPort:      2229,
+				Description:       "smtp",
+			},
+			{
+				Protocol:          "tcp",
+				InternalPortID:    "070ef0b2-0175-4299-be5c-01fea8cca523",
+				InternalIPAddress: "10.0.0.25",
+				InternalPort:      80,
+				ID:                "725ade3c-9760-4880-8080-8fc2dbab9acd",
+				ExternalPort:      2230,
+			},
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePortForwardingCreationSuccessfully(t, PortForwardingResponse)
+
+	options := portforwarding.CreateOpts{
+		Protocol:          "tcp",
+		InternalIPAddress: "10.0.0.11",
+		InternalPort:      25,
+		ExternalPort:      2230,
+		InternalPortID:    "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+		Description:       "smtp",
+	}
+
+	pf, err := portforwarding.Create(fake.ServiceClient(), "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e", options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "725ade3c-9760-4880-8080-8fc2dbab9acc", pf.ID)
+	th.AssertEquals(t, "10.0.0.11", pf.InternalIPAddress)
+	th.AssertEquals(t, 25, pf.InternalPort)
+	th.AssertEquals(t, "1238be08-a2a8-4b8d-addf-fb5e2250e480", pf.InternalPortID)
+	th.AssertEquals(t, 2230, pf.ExternalPort)
+	th.AssertEquals(t, "tcp", pf.Protocol)
+	th.AssertEquals(t, "smtp", pf.Description)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := portforwarding.Create(fake.ServiceClient(), "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e", portforwarding.CreateOpts{Protocol: "tcp"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e/port_forwardings/725ade3c-9760-4880-8080-8fc2dbab9acc", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, PortForwardingResponse)
+	})
+
+	pf, err := portforwarding.Get(fake.ServiceClient(), "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e", "725ade3c-9760-4880-8080-8fc2dbab9acc").Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "tcp", pf.Protocol)
+	th.AssertEquals(t, "725ade3c-9760-4880-8080-8fc2dbab9acc", pf.ID)
+	th.AssertEquals(t, "10.0.0.11", pf.InternalIPAddress)
+	th.AssertEquals(t, 25, pf.InternalPort)
+	th.AssertEquals(t, "1238be08-a2a8-4b8d-addf-fb5e2250e480", pf.InternalPortID)
+	th.AssertEquals(t, 2230, pf.ExternalPort)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e/port_forwardings/725ade3c-9760-4880-8080-8fc2dbab9acc", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, UpdateResponse)
+	})
+
+	updatedProtocol := "udp"
+	updatedInternalPort := 37
+	updatedInternalPortID := "99889dc2-19a7-4edb-b9d0-d2ace8d1e144"
+	updatedExternalPort := 1960
+	options := portforwarding.UpdateOpts{
+		Protocol:       updatedProtocol,
+		InternalPort:   updatedInternalPort,
+		InternalPortID: updatedInternalPortID,
+		ExternalPort:   updatedExternalPort,
+	}
+
+	actual, err := portforwarding.Update(fake.ServiceClient(), "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e", "725ade3c-9760-4880-8080-8fc2dbab9acc", options).Extract()
+	th.AssertNoErr(t, err)
+	expected := portforwarding.PortForwarding{
+		Protocol:          "udp",
+		InternalIPAddress: "10.0.0.14",
+		InternalPort:      37,
+		ID:                "725ade3c-9760-4880-8080-8fc2dbab9acc",
+		InternalPortID:    "99889dc2-19a7-4edb-b9d0-d2ace8d1e144",
+		ExternalPort:      1960,
+		Description:       "smtp",
+	}
+	th.AssertDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e/port_forwardings/725ade3c-9760-4880-8080-8fc2dbab9acc", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := portforwarding.Delete(fake.ServiceClient(), "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e", "725ade3c-9760-4880-8080-8fc2dbab9acc")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,14 @@
+package portforwarding
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "floatingips"
+const portForwardingPath = "port_forwardings"
+
+func portForwardingURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, portForwardingPath)
+}
+
+func singlePortForwardingURL(c *gophercloud.ServiceClient, id string, portForwardingID string) string {
+	return c.ServiceURL(resourcePath, id, portForwardingPath, portForwardingID)
+}
@@ -37,6 +37,27 @@ Example to Create a Router
 		panic(err)
 	}
 
+Example to Create a Router with a specific External Fixed IP and SNAT disabled
+
+	iFalse := false
+	gwi := routers.GatewayInfo{
+		NetworkID:  "8ca37218-28ff-41cb-9b10-039601ea7e6b",
+		EnableSNAT: &iFalse,
+		ExternalFixedIPs: []routers.ExternalFixedIP{
+			{SubnetID: "ab561bc4-1a8e-48f2-9fbd-376fcb1a1def"},
+		},
+	}
+
+	createOpts := routers.CreateOpts{
+		Name:        "router_1",
+		GatewayInfo: &gwi,
+	}
+
+	router, err := routers.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Router
 
 	routerID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
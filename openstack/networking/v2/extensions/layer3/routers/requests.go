@@ -22,6 +22,10 @@ type ListOpts struct {
 	Marker       string `q:"marker"`
 	SortKey      string `q:"sort_key"`
 	SortDir      string `q:"sort_dir"`
+	Tags         string `q:"tags"`
+	TagsAny      string `q:"tags-any"`
+	NotTags      string `q:"not-tags"`
+	NotTagsAny   string `q:"not-tags-any"`
 }
 
 // List returns a Pager which allows you to iterate over a collection of
@@ -105,14 +109,27 @@ type UpdateOpts struct {
 
 // ToRouterUpdateMap builds an update body based on UpdateOpts.
 func (opts UpdateOpts) ToRouterUpdateMap() (map[string]interface{}, error) {
-	return gophercloud.BuildRequestBody(opts, "router")
+	b, err := gophercloud.BuildRequestBody(opts, "router")
+	if err != nil {
+		return nil, err
+	}
+
+	// A nil Routes leaves the routes of the router untouched, while an empty
+	// Routes removes all of them.
+	if opts.Routes == nil {
+		delete(b["router"].(map[string]interface{}), "routes")
+	}
+
+	return b, nil
 }
 
 // Update allows routers to be updated. You can update the name, administrative
-// state, and the external gateway. For more information about how to set the
+// state, routes, and the external gateway, including its external fixed IPs
+// and whether SNAT is enabled. For more information about how to set the
 // external gateway for a router, see Create. This operation does not enable
 // the update of router interfaces. To do this, use the AddInterface and
-// RemoveInterface functions.
+// RemoveInterface functions. To add or remove individual routes, use the
+// extraroutes package.
 func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
 	b, err := opts.ToRouterUpdateMap()
 	if err != nil {
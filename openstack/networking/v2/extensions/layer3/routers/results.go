@@ -14,10 +14,11 @@ type GatewayInfo struct {
 }
 
 // ExternalFixedIP is the IP address and subnet ID of the external gateway of a
-// router.
+// router. When creating or updating a router, either field may be omitted to
+// let the Networking service choose the address or the subnet.
 type ExternalFixedIP struct {
-	IPAddress string `json:"ip_address"`
-	SubnetID  string `json:"subnet_id"`
+	IPAddress string `json:"ip_address,omitempty"`
+	SubnetID  string `json:"subnet_id,omitempty"`
 }
 
 // Route is a possible route in a router.
@@ -67,6 +68,9 @@ type Router struct {
 	// Availability zone hints groups network nodes that run services like DHCP, L3, FW, and others.
 	// Used to make network resources highly available.
 	AvailabilityZoneHints []string `json:"availability_zone_hints"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
 }
 
 // RouterPage is the page returned by a pager when traversing over a
@@ -0,0 +1,41 @@
+/*
+Package networkipavailabilities provides the ability to retrieve the IP
+address usage of networks and their subnets through the Neutron API.
+
+Example of Listing NetworkIPAvailabilities
+
+	allPages, err := networkipavailabilities.List(networkClient, networkipavailabilities.ListOpts{}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAvailabilities, err := networkipavailabilities.ExtractNetworkIPAvailabilities(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, availability := range allAvailabilities {
+		fmt.Printf("%+v\n", availability)
+	}
+
+Example of Getting a single NetworkIPAvailability
+
+	availability, err := networkipavailabilities.Get(networkClient, "cf11ab78-2302-49fa-870f-851a08c7afb8").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", availability)
+
+Example of Reporting Subnet Usage
+
+	availability, err := networkipavailabilities.Get(networkClient, "cf11ab78-2302-49fa-870f-851a08c7afb8").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, subnet := range availability.SubnetIPAvailabilities {
+		fmt.Printf("%s: %s of %s addresses used\n", subnet.CIDR, subnet.UsedIPs, subnet.TotalIPs)
+	}
+*/
+package networkipavailabilities
@@ -0,0 +1,2 @@
+// networkipavailabilities unit tests
+package testing
@@ -0,0 +1,135 @@
+/*
+Package rules provides the ability to retrieve and manage QoS policy rules
+through the Neutron API. Bandwidth limit, DSCP marking, minimum bandwidth and
+minimum packet rate rules are supported. The rule types supported by the
+loaded QoS drivers can be discovered with the ruletypes package.
+
+Example of Listing BandwidthLimitRules
+
+	listOpts := rules.BandwidthLimitRulesListOpts{
+		MaxKBps: 3000,
+	}
+
+	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
+
+	allPages, err := rules.ListBandwidthLimitRules(networkClient, policyID, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allBandwidthLimitRules, err := rules.ExtractBandwidthLimitRules(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, bandwidthLimitRule := range allBandwidthLimitRules {
+		fmt.Printf("%+v\n", bandwidthLimitRule)
+	}
+
+Example of Getting a single BandwidthLimitRule
+
+	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
+	ruleID := "30a57f4a-336b-4382-8275-d708babd2241"
+
+	rule, err := rules.GetBandwidthLimitRule(networkClient, policyID, ruleID).ExtractBandwidthLimitRule()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Rule: %+v\n", rule)
+
+Example of Creating a single BandwidthLimitRule
+
+	opts := rules.CreateBandwidthLimitRuleOpts{
+		MaxKBps:      2000,
+		MaxBurstKBps: 200,
+	}
+
+	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
+
+	rule, err := rules.CreateBandwidthLimitRule(networkClient, policyID, opts).ExtractBandwidthLimitRule()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Rule: %+v\n", rule)
+
+Example of Updating a single BandwidthLimitRule
+
+	maxKBps := 500
+	maxBurstKBps := 0
+
+	opts := rules.UpdateBandwidthLimitRuleOpts{
+		MaxKBps:      &maxKBps,
+		MaxBurstKBps: &maxBurstKBps,
+	}
+
+	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
+	ruleID := "30a57f4a-336b-4382-8275-d708babd2241"
+
+	rule, err := rules.UpdateBandwidthLimitRule(networkClient, policyID, ruleID, opts).ExtractBandwidthLimitRule()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Rule: %+v\n", rule)
+
+Example of Deleting a single BandwidthLimitRule
+
+	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
+	ruleID := "30a57f4a-336b-4382-8275-d708babd2241"
+
+	err := rules.DeleteBandwidthLimitRule(networkClient, policyID, ruleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Creating a single DSCPMarkingRule
+
+	dscpMark := 20
+	opts := rules.CreateDSCPMarkingRuleOpts{
+		DSCPMark: &dscpMark,
+	}
+
+	policyID := "7fb5e8a0-2d0a-4b3a-8b8d-5d3d5d1f4b6a"
+
+	rule, err := rules.CreateDSCPMarkingRule(networkClient, policyID, opts).ExtractDSCPMarkingRule()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Rule: %+v\n", rule)
+
+Example of Creating a single MinimumBandwidthRule
+
+	opts := rules.CreateMinimumBandwidthRuleOpts{
+		MinKBps:   2000,
+		Direction: "egress",
+	}
+
+	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
+
+	rule, err := rules.CreateMinimumBandwidthRule(networkClient, policyID, opts).ExtractMinimumBandwidthRule()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Rule: %+v\n", rule)
+
+Example of Creating a single MinimumPacketRateRule
+
+	opts := rules.CreateMinimumPacketRateRuleOpts{
+		MinKpps:   1000,
+		Direction: "any",
+	}
+
+	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
+
+	rule, err := rules.CreateMinimumPacketRateRule(networkClient, policyID, opts).ExtractMinimumPacketRateRule()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Rule: %+v\n", rule)
+*/
+package rules
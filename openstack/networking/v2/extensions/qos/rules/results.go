@@ -0,0 +1,343 @@
+package rules
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// ExtractBandwidthLimitRule is a function that accepts a result and extracts a BandwidthLimitRule.
+func (r commonResult) ExtractBandwidthLimitRule() (*BandwidthLimitRule, error) {
+	var s struct {
+		BandwidthLimitRule *BandwidthLimitRule `json:"bandwidth_limit_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.BandwidthLimitRule, err
+}
+
+// GetBandwidthLimitRuleResult represents the result of a Get operation. Call its ExtractBandwidthLimitRule
+// method to interpret it as a BandwidthLimitRule.
+type GetBandwidthLimitRuleResult struct {
+	commonResult
+}
+
+// CreateBandwidthLimitRuleResult represents the result of a Create operation. Call its ExtractBandwidthLimitRule
+// method to interpret it as a BandwidthLimitRule.
+type CreateBandwidthLimitRuleResult struct {
+	commonResult
+}
+
+// UpdateBandwidthLimitRuleResult represents the result of an Update operation. Call its ExtractBandwidthLimitRule
+// method to interpret it as a BandwidthLimitRule.
+type UpdateBandwidthLimitRuleResult struct {
+	commonResult
+}
+
+// DeleteBandwidthLimitRuleResult represents the result of a Delete operation. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteBandwidthLimitRuleResult struct {
+	gophercloud.ErrResult
+}
+
+// BandwidthLimitRule represents a QoS policy rule to set bandwidth limits.
+type BandwidthLimitRule struct {
+	// ID is a unique ID of the rule.
+	ID string `json:"id"`
+
+	// MaxKBps is a maximum kilobits per second.
+	MaxKBps int `json:"max_kbps"`
+
+	// MaxBurstKBps is a maximum burst size in kilobits.
+	MaxBurstKBps int `json:"max_burst_kbps"`
+
+	// Direction represents the direction of traffic.
+	Direction string `json:"direction"`
+}
+
+// BandwidthLimitRulePage stores a single page of BandwidthLimitRules from a List() API call.
+type BandwidthLimitRulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of bandwidth limit rules has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r BandwidthLimitRulePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"bandwidth_limit_rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a BandwidthLimitRulePage is empty.
+func (r BandwidthLimitRulePage) IsEmpty() (bool, error) {
+	is, err := ExtractBandwidthLimitRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractBandwidthLimitRules accepts a BandwidthLimitRulePage, and extracts the elements into a slice of
+// BandwidthLimitRules.
+func ExtractBandwidthLimitRules(r pagination.Page) ([]BandwidthLimitRule, error) {
+	var s []BandwidthLimitRule
+	err := ExtractBandwidthLimitRulesInto(r, &s)
+	return s, err
+}
+
+// ExtractBandwidthLimitRulesInto extracts the elements into a slice of BandwidthLimitRule structs.
+func ExtractBandwidthLimitRulesInto(r pagination.Page, v interface{}) error {
+	return r.(BandwidthLimitRulePage).Result.ExtractIntoSlicePtr(v, "bandwidth_limit_rules")
+}
+
+// ExtractDSCPMarkingRule is a function that accepts a result and extracts a DSCPMarkingRule.
+func (r commonResult) ExtractDSCPMarkingRule() (*DSCPMarkingRule, error) {
+	var s struct {
+		DSCPMarkingRule *DSCPMarkingRule `json:"dscp_marking_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.DSCPMarkingRule, err
+}
+
+// GetDSCPMarkingRuleResult represents the result of a Get operation. Call its ExtractDSCPMarkingRule
+// method to interpret it as a DSCPMarkingRule.
+type GetDSCPMarkingRuleResult struct {
+	commonResult
+}
+
+// CreateDSCPMarkingRuleResult represents the result of a Create operation. Call its ExtractDSCPMarkingRule
+// method to interpret it as a DSCPMarkingRule.
+type CreateDSCPMarkingRuleResult struct {
+	commonResult
+}
+
+// UpdateDSCPMarkingRuleResult represents the result of an Update operation. Call its ExtractDSCPMarkingRule
+// method to interpret it as a DSCPMarkingRule.
+type UpdateDSCPMarkingRuleResult struct {
+	commonResult
+}
+
+// DeleteDSCPMarkingRuleResult represents the result of a Delete operation. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteDSCPMarkingRuleResult struct {
+	gophercloud.ErrResult
+}
+
+// DSCPMarkingRule represents a QoS policy rule to set DSCP marking.
+type DSCPMarkingRule struct {
+	// ID is a unique ID of the rule.
+	ID string `json:"id"`
+
+	// DSCPMark contains DSCP mark value.
+	DSCPMark int `json:"dscp_mark"`
+}
+
+// DSCPMarkingRulePage stores a single page of DSCPMarkingRules from a List() API call.
+type DSCPMarkingRulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of DSCP marking rules has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r DSCPMarkingRulePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"dscp_marking_rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a DSCPMarkingRulePage is empty.
+func (r DSCPMarkingRulePage) IsEmpty() (bool, error) {
+	is, err := ExtractDSCPMarkingRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractDSCPMarkingRules accepts a DSCPMarkingRulePage, and extracts the elements into a slice of
+// DSCPMarkingRules.
+func ExtractDSCPMarkingRules(r pagination.Page) ([]DSCPMarkingRule, error) {
+	var s []DSCPMarkingRule
+	err := ExtractDSCPMarkingRulesInto(r, &s)
+	return s, err
+}
+
+// ExtractDSCPMarkingRulesInto extracts the elements into a slice of DSCPMarkingRule structs.
+func ExtractDSCPMarkingRulesInto(r pagination.Page, v interface{}) error {
+	return r.(DSCPMarkingRulePage).Result.ExtractIntoSlicePtr(v, "dscp_marking_rules")
+}
+
+// ExtractMinimumBandwidthRule is a function that accepts a result and extracts a MinimumBandwidthRule.
+func (r commonResult) ExtractMinimumBandwidthRule() (*MinimumBandwidthRule, error) {
+	var s struct {
+		MinimumBandwidthRule *MinimumBandwidthRule `json:"minimum_bandwidth_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.MinimumBandwidthRule, err
+}
+
+// GetMinimumBandwidthRuleResult represents the result of a Get operation. Call its ExtractMinimumBandwidthRule
+// method to interpret it as a MinimumBandwidthRule.
+type GetMinimumBandwidthRuleResult struct {
+	commonResult
+}
+
+// CreateMinimumBandwidthRuleResult represents the result of a Create operation. Call its ExtractMinimumBandwidthRule
+// method to interpret it as a MinimumBandwidthRule.
+type CreateMinimumBandwidthRuleResult struct {
+	commonResult
+}
+
+// UpdateMinimumBandwidthRuleResult represents the result of an Update operation. Call its ExtractMinimumBandwidthRule
+// method to interpret it as a MinimumBandwidthRule.
+type UpdateMinimumBandwidthRuleResult struct {
+	commonResult
+}
+
+// DeleteMinimumBandwidthRuleResult represents the result of a Delete operation. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteMinimumBandwidthRuleResult struct {
+	gophercloud.ErrResult
+}
+
+// MinimumBandwidthRule represents a QoS policy rule to set minimum bandwidth.
+type MinimumBandwidthRule struct {
+	// ID is a unique ID of the rule.
+	ID string `json:"id"`
+
+	// MinKBps is a minimum kilobits per second.
+	MinKBps int `json:"min_kbps"`
+
+	// Direction represents the direction of traffic.
+	Direction string `json:"direction"`
+}
+
+// MinimumBandwidthRulePage stores a single page of MinimumBandwidthRules from a List() API call.
+type MinimumBandwidthRulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of minimum bandwidth rules has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r MinimumBandwidthRulePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"minimum_bandwidth_rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a MinimumBandwidthRulePage is empty.
+func (r MinimumBandwidthRulePage) IsEmpty() (bool, error) {
+	is, err := ExtractMinimumBandwidthRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractMinimumBandwidthRules accepts a MinimumBandwidthRulePage, and extracts the elements into a slice of
+// MinimumBandwidthRules.
+func ExtractMinimumBandwidthRules(r pagination.Page) ([]MinimumBandwidthRule, error) {
+	var s []MinimumBandwidthRule
+	err := ExtractMinimumBandwidthRulesInto(r, &s)
+	return s, err
+}
+
+// ExtractMinimumBandwidthRulesInto extracts the elements into a slice of MinimumBandwidthRule structs.
+func ExtractMinimumBandwidthRulesInto(r pagination.Page, v interface{}) error {
+	return r.(MinimumBandwidthRulePage).Result.ExtractIntoSlicePtr(v, "minimum_bandwidth_rules")
+}
+
+// ExtractMinimumPacketRateRule is a function that accepts a result and extracts a MinimumPacketRateRule.
+func (r commonResult) ExtractMinimumPacketRateRule() (*MinimumPacketRateRule, error) {
+	var s struct {
+		MinimumPacketRateRule *MinimumPacketRateRule `json:"minimum_packet_rate_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.MinimumPacketRateRule, err
+}
+
+// GetMinimumPacketRateRuleResult represents the result of a Get operation. Call its ExtractMinimumPacketRateRule
+// method to interpret it as a MinimumPacketRateRule.
+type GetMinimumPacketRateRuleResult struct {
+	commonResult
+}
+
+// CreateMinimumPacketRateRuleResult represents the result of a Create operation. Call its ExtractMinimumPacketRateRule
+// method to interpret it as a MinimumPacketRateRule.
+type CreateMinimumPacketRateRuleResult struct {
+	commonResult
+}
+
+// UpdateMinimumPacketRateRuleResult represents the result of an Update operation. Call its ExtractMinimumPacketRateRule
+// method to interpret it as a MinimumPacketRateRule.
+type UpdateMinimumPacketRateRuleResult struct {
+	commonResult
+}
+
+// DeleteMinimumPacketRateRuleResult represents the result of a Delete operation. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type DeleteMinimumPacketRateRuleResult struct {
+	gophercloud.ErrResult
+}
+
+// MinimumPacketRateRule represents a QoS policy rule to set a minimum packet rate.
+type MinimumPacketRateRule struct {
+	// ID is a unique ID of the rule.
+	ID string `json:"id"`
+
+	// MinKpps is a minimum kilo packets per second.
+	MinKpps int `json:"min_kpps"`
+
+	// Direction represents the direction of traffic. It can be
+	// "egress", "ingress" or "any".
+	Direction string `json:"direction"`
+}
+
+// MinimumPacketRateRulePage stores a single page of MinimumPacketRateRules from a List() API call.
+type MinimumPacketRateRulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of minimum packet rate rules has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r MinimumPacketRateRulePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"minimum_packet_rate_rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a MinimumPacketRateRulePage is empty.
+func (r MinimumPacketRateRulePage) IsEmpty() (bool, error) {
+	is, err := ExtractMinimumPacketRateRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractMinimumPacketRateRules accepts a MinimumPacketRateRulePage, and extracts the elements into a slice of
+// MinimumPacketRateRules.
+func ExtractMinimumPacketRateRules(r pagination.Page) ([]MinimumPacketRateRule, error) {
+	var s []MinimumPacketRateRule
+	err := ExtractMinimumPacketRateRulesInto(r, &s)
+	return s, err
+}
+
+// ExtractMinimumPacketRateRulesInto extracts the elements into a slice of MinimumPacketRateRule structs.
+func ExtractMinimumPacketRateRulesInto(r pagination.Page, v interface{}) error {
+	return r.(MinimumPacketRateRulePage).Result.ExtractIntoSlicePtr(v, "minimum_packet_rate_rules")
+}
@@ -0,0 +1,162 @@
+package testing
+
+// BandwidthLimitRulesListResult represents a raw result of a List call to BandwidthLimitRules.
+const BandwidthLimitRulesListResult = `
+{
+    "bandwidth_limit_rules": [
+        {
+            "max_kbps": 3000,
+            "direction": "egress",
+            "id": "30a57f4a-336b-4382-8275-d708babd2241",
+            "max_burst_kbps": 300
+        }
+    ]
+}
+`
+
+// BandwidthLimitRulesGetResult represents a raw result of a Get call to a specific BandwidthLimitRule.
+const BandwidthLimitRulesGetResult = `
+{
+    "bandwidth_limit_rule": {
+        "max_kbps": 3000,
+        "direction": "egress",
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "max_burst_kbps": 300
+    }
+}
+`
+
+// BandwidthLimitRulesCreateRequest represents a raw body of a Create BandwidthLimitRule call.
+const BandwidthLimitRulesCreateRequest = `
+{
+    "bandwidth_limit_rule": {
+        "max_kbps": 2000,
+        "max_burst_kbps": 200
+    }
+}
+`
+
+// BandwidthLimitRulesCreateResult represents a raw result of a Create BandwidthLimitRule call.
+const BandwidthLimitRulesCreateResult = `
+{
+    "bandwidth_limit_rule": {
+        "max_kbps": 2000,
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "max_burst_kbps": 200
+    }
+}
+`
+
+// BandwidthLimitRulesUpdateRequest represents a raw body of a Update BandwidthLimitRule call.
+const BandwidthLimitRulesUpdateRequest = `
+{
+    "bandwidth_limit_rule": {
+        "max_kbps": 500,
+        "max_burst_kbps": 0
+    }
+}
+`
+
+// BandwidthLimitRulesUpdateResult represents a raw result of a Update BandwidthLimitRule call.
+const BandwidthLimitRulesUpdateResult = `
+{
+    "bandwidth_limit_rule": {
+        "max_kbps": 500,
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "max_burst_kbps": 0
+    }
+}
+`
+
+// DSCPMarkingRuleCreateRequest represents a raw body of a Create DSCPMarkingRule call.
+const DSCPMarkingRuleCreateRequest = `
+{
+    "dscp_marking_rule": {
+        "dscp_mark": 20
+    }
+}
+`
+
+// DSCPMarkingRuleCreateResult represents a raw result of a Create DSCPMarkingRule call.
+const DSCPMarkingRuleCreateResult = `
+{
+    "dscp_marking_rule": {
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "dscp_mark": 20
+    }
+}
+`
+
+// MinimumBandwidthRulesListResult represents a raw result of a List call to MinimumBandwidthRules.
+const MinimumBandwidthRulesListResult = `
+{
+    "minimum_bandwidth_rules": [
+        {
+            "min_kbps": 3000,
+            "direction": "egress",
+            "id": "30a57f4a-336b-4382-8275-d708babd2241"
+        }
+    ]
+}
+`
+
+// MinimumBandwidthRulesCreateRequest represents a raw body of a Create MinimumBandwidthRule call.
+const MinimumBandwidthRulesCreateRequest = `
+{
+    "minimum_bandwidth_rule": {
+        "min_kbps": 2000
+    }
+}
+`
+
+// MinimumBandwidthRulesCreateResult represents a raw result of a Create MinimumBandwidthRule call.
+const MinimumBandwidthRulesCreateResult = `
+{
+    "minimum_bandwidth_rule": {
+        "min_kbps": 2000,
+        "direction": "egress",
+        "id": "30a57f4a-336b-4382-8275-d708babd2241"
+    }
+}
+`
+
+// MinimumPacketRateRuleCreateRequest represents a raw body of a Create MinimumPacketRateRule call.
+const MinimumPacketRateRuleCreateRequest = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 1000,
+        "direction": "any"
+    }
+}
+`
+
+// MinimumPacketRateRuleCreateResult represents a raw result of a Create MinimumPacketRateRule call.
+const MinimumPacketRateRuleCreateResult = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 1000,
+        "direction": "any",
+        "id": "1eddf7be-ab8e-4bc8-9a0c-76d4e4b6d541"
+    }
+}
+`
+
+// MinimumPacketRateRuleUpdateRequest represents a raw body of a Update MinimumPacketRateRule call.
+const MinimumPacketRateRuleUpdateRequest = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 2000
+    }
+}
+`
+
+// MinimumPacketRateRuleUpdateResult represents a raw result of a Update MinimumPacketRateRule call.
+const MinimumPacketRateRuleUpdateResult = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 2000,
+        "direction": "any",
+        "id": "1eddf7be-ab8e-4bc8-9a0c-76d4e4b6d541"
+    }
+}
+`
@@ -0,0 +1,332 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/rules"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestListBandwidthLimitRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/bandwidth_limit_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"max_kbps": "3000"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, BandwidthLimitRulesListResult)
+	})
+
+	count := 0
+
+	err := rules.ListBandwidthLimitRules(
+		fake.ServiceClient(),
+		"501005fa-3b56-4061-aaca-3f24995112e1",
+		rules.BandwidthLimitRulesListOpts{MaxKBps: 3000},
+	).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := rules.ExtractBandwidthLimitRules(page)
+		if err != nil {
+			t.Errorf("Failed to extract bandwidth limit rules: %v", err)
+			return false, nil
+		}
+
+		expected := []rules.BandwidthLimitRule{
+			{
+				ID:           "30a57f4a-336b-4382-8275-d708babd2241",
+				MaxKBps:      3000,
+				MaxBurstKBps: 300,
+				Direction:    "egress",
+			},
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGetBandwidthLimitRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/bandwidth_limit_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, BandwidthLimitRulesGetResult)
+	})
+
+	r, err := rules.GetBandwidthLimitRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241").ExtractBandwidthLimitRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, r.ID, "30a57f4a-336b-4382-8275-d708babd2241")
+	th.AssertEquals(t, r.Direction, "egress")
+	th.AssertEquals(t, r.MaxBurstKBps, 300)
+	th.AssertEquals(t, r.MaxKBps, 3000)
+}
+
+func TestCreateBandwidthLimitRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/bandwidth_limit_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, BandwidthLimitRulesCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, BandwidthLimitRulesCreateResult)
+	})
+
+	opts := rules.CreateBandwidthLimitRuleOpts{
+		MaxKBps:      2000,
+		MaxBurstKBps: 200,
+	}
+	r, err := rules.CreateBandwidthLimitRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", opts).ExtractBandwidthLimitRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 200, r.MaxBurstKBps)
+	th.AssertEquals(t, 2000, r.MaxKBps)
+}
+
+func TestUpdateBandwidthLimitRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/bandwidth_limit_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, BandwidthLimitRulesUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, BandwidthLimitRulesUpdateResult)
+	})
+
+	maxKBps := 500
+	maxBurstKBps := 0
+	opts := rules.UpdateBandwidthLimitRuleOpts{
+		MaxKBps:      &maxKBps,
+		MaxBurstKBps: &maxBurstKBps,
+	}
+	r, err := rules.UpdateBandwidthLimitRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241", opts).ExtractBandwidthLimitRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 0, r.MaxBurstKBps)
+	th.AssertEquals(t, 500, r.MaxKBps)
+}
+
+func TestDeleteBandwidthLimitRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/bandwidth_limit_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := rules.DeleteBandwidthLimitRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestCreateDSCPMarkingRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/dscp_marking_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, DSCPMarkingRuleCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, DSCPMarkingRuleCreateResult)
+	})
+
+	dscpMark := 20
+	opts := rules.CreateDSCPMarkingRuleOpts{
+		DSCPMark: &dscpMark,
+	}
+	r, err := rules.CreateDSCPMarkingRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", opts).ExtractDSCPMarkingRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "30a57f4a-336b-4382-8275-d708babd2241", r.ID)
+	th.AssertEquals(t, 20, r.DSCPMark)
+}
+
+func TestCreateDSCPMarkingRuleZeroMark(t *testing.T) {
+	dscpMark := 0
+	b, err := rules.CreateDSCPMarkingRuleOpts{DSCPMark: &dscpMark}.ToDSCPMarkingRuleCreateMap()
+	th.AssertNoErr(t, err)
+
+	th.AssertJSONEquals(t, `{"dscp_marking_rule": {"dscp_mark": 0}}`, b)
+
+	_, err = rules.CreateDSCPMarkingRuleOpts{}.ToDSCPMarkingRuleCreateMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput when DSCPMark is missing, got %#v", err)
+	}
+}
+
+func TestListDSCPMarkingRulesZeroMarkQuery(t *testing.T) {
+	dscpMark := 0
+	q, err := rules.DSCPMarkingRulesListOpts{DSCPMark: &dscpMark}.ToDSCPMarkingRulesListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?dscp_mark=0", q)
+}
+
+func TestListMinimumBandwidthRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_bandwidth_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, MinimumBandwidthRulesListResult)
+	})
+
+	allPages, err := rules.ListMinimumBandwidthRules(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := rules.ExtractMinimumBandwidthRules(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []rules.MinimumBandwidthRule{
+		{
+			ID:        "30a57f4a-336b-4382-8275-d708babd2241",
+			MinKBps:   3000,
+			Direction: "egress",
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestCreateMinimumBandwidthRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_bandwidth_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, MinimumBandwidthRulesCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, MinimumBandwidthRulesCreateResult)
+	})
+
+	opts := rules.CreateMinimumBandwidthRuleOpts{
+		MinKBps: 2000,
+	}
+	r, err := rules.CreateMinimumBandwidthRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", opts).ExtractMinimumBandwidthRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2000, r.MinKBps)
+	th.AssertEquals(t, "egress", r.Direction)
+}
+
+func TestCreateMinimumPacketRateRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, MinimumPacketRateRuleCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, MinimumPacketRateRuleCreateResult)
+	})
+
+	opts := rules.CreateMinimumPacketRateRuleOpts{
+		MinKpps:   1000,
+		Direction: "any",
+	}
+	r, err := rules.CreateMinimumPacketRateRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", opts).ExtractMinimumPacketRateRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "1eddf7be-ab8e-4bc8-9a0c-76d4e4b6d541", r.ID)
+	th.AssertEquals(t, 1000, r.MinKpps)
+	th.AssertEquals(t, "any", r.Direction)
+}
+
+func TestUpdateMinimumPacketRateRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules/1eddf7be-ab8e-4bc8-9a0c-76d4e4b6d541", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, MinimumPacketRateRuleUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, MinimumPacketRateRuleUpdateResult)
+	})
+
+	minKpps := 2000
+	opts := rules.UpdateMinimumPacketRateRuleOpts{
+		MinKpps: &minKpps,
+	}
+	r, err := rules.UpdateMinimumPacketRateRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "1eddf7be-ab8e-4bc8-9a0c-76d4e4b6d541", opts).ExtractMinimumPacketRateRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2000, r.MinKpps)
+}
+
+func TestDeleteMinimumPacketRateRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules/1eddf7be-ab8e-4bc8-9a0c-76d4e4b6d541", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := rules.DeleteMinimumPacketRateRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "1eddf7be-ab8e-4bc8-9a0c-76d4e4b6d541")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,125 @@
+package rules
+
+import "github.com/gophercloud/gophercloud"
+
+const (
+	rootPath = "qos"
+
+	policiesPath                       = "policies"
+	bandwidthLimitRulesResourcePath    = "bandwidth_limit_rules"
+	dSCPMarkingRulesResourcePath       = "dscp_marking_rules"
+	minimumBandwidthRulesResourcePath  = "minimum_bandwidth_rules"
+	minimumPacketRateRulesResourcePath = "minimum_packet_rate_rules"
+)
+
+func bandwidthLimitRulesRootURL(c *gophercloud.ServiceClient, policyID string) string {
+	return c.ServiceURL(rootPath, policiesPath, policyID, bandwidthLimitRulesResourcePath)
+}
+
+func bandwidthLimitRulesResourceURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return c.ServiceURL(rootPath, policiesPath, policyID, bandwidthLimitRulesResourcePath, ruleID)
+}
+
+func listBandwidthLimitRulesURL(c *gophercloud.ServiceClient, policyID string) string {
+	return bandwidthLimitRulesRootURL(c, policyID)
+}
+
+func getBandwidthLimitRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return bandwidthLimitRulesResourceURL(c, policyID, ruleID)
+}
+
+func createBandwidthLimitRuleURL(c *gophercloud.ServiceClient, policyID string) string {
+	return bandwidthLimitRulesRootURL(c, policyID)
+}
+
+func updateBandwidthLimitRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return bandwidthLimitRulesResourceURL(c, policyID, ruleID)
+}
+
+func deleteBandwidthLimitRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return bandwidthLimitRulesResourceURL(c, policyID, ruleID)
+}
+
+func dSCPMarkingRulesRootURL(c *gophercloud.ServiceClient, policyID string) string {
+	return c.ServiceURL(rootPath, policiesPath, policyID, dSCPMarkingRulesResourcePath)
+}
+
+func dSCPMarkingRulesResourceURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return c.ServiceURL(rootPath, policiesPath, policyID, dSCPMarkingRulesResourcePath, ruleID)
+}
+
+func listDSCPMarkingRulesURL(c *gophercloud.ServiceClient, policyID string) string {
+	return dSCPMarkingRulesRootURL(c, policyID)
+}
+
+func getDSCPMarkingRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return dSCPMarkingRulesResourceURL(c, policyID, ruleID)
+}
+
+func createDSCPMarkingRuleURL(c *gophercloud.ServiceClient, policyID string) string {
+	return dSCPMarkingRulesRootURL(c, policyID)
+}
+
+func updateDSCPMarkingRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return dSCPMarkingRulesResourceURL(c, policyID, ruleID)
+}
+
+func deleteDSCPMarkingRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return dSCPMarkingRulesResourceURL(c, policyID, ruleID)
+}
+
+func minimumBandwidthRulesRootURL(c *gophercloud.ServiceClient, policyID string) string {
+	return c.ServiceURL(rootPath, policiesPath, policyID, minimumBandwidthRulesResourcePath)
+}
+
+func minimumBandwidthRulesResourceURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return c.ServiceURL(rootPath, policiesPath, policyID, minimumBandwidthRulesResourcePath, ruleID)
+}
+
+func listMinimumBandwidthRulesURL(c *gophercloud.ServiceClient, policyID string) string {
+	return minimumBandwidthRulesRootURL(c, policyID)
+}
+
+func getMinimumBandwidthRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return minimumBandwidthRulesResourceURL(c, policyID, ruleID)
+}
+
+func createMinimumBandwidthRuleURL(c *gophercloud.ServiceClient, policyID string) string {
+	return minimumBandwidthRulesRootURL(c, policyID)
+}
+
+func updateMinimumBandwidthRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return minimumBandwidthRulesResourceURL(c, policyID, ruleID)
+}
+
+func deleteMinimumBandwidthRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return minimumBandwidthRulesResourceURL(c, policyID, ruleID)
+}
+
+func minimumPacketRateRulesRootURL(c *gophercloud.ServiceClient, policyID string) string {
+	return c.ServiceURL(rootPath, policiesPath, policyID, minimumPacketRateRulesResourcePath)
+}
+
+func minimumPacketRateRulesResourceURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return c.ServiceURL(rootPath, policiesPath, policyID, minimumPacketRateRulesResourcePath, ruleID)
+}
+
+func listMinimumPacketRateRulesURL(c *gophercloud.ServiceClient, policyID string) string {
+	return minimumPacketRateRulesRootURL(c, policyID)
+}
+
+func getMinimumPacketRateRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return minimumPacketRateRulesResourceURL(c, policyID, ruleID)
+}
+
+func createMinimumPacketRateRuleURL(c *gophercloud.ServiceClient, policyID string) string {
+	return minimumPacketRateRulesRootURL(c, policyID)
+}
+
+func updateMinimumPacketRateRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return minimumPacketRateRulesResourceURL(c, policyID, ruleID)
+}
+
+func deleteMinimumPacketRateRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return minimumPacketRateRulesResourceURL(c, policyID, ruleID)
+}
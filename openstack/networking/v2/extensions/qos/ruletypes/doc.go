@@ -0,0 +1,33 @@
+/*
+Package ruletypes contains functionality for working with Neutron 'quality of
+service' rule-type resources. The rule types are the kinds of rules of the
+rules package which are supported by the QoS drivers of the deployment.
+
+Example of Listing QoS rule types
+
+	page, err := ruletypes.ListRuleTypes(networkClient).AllPages()
+	if err != nil {
+		return
+	}
+
+	rules, err := ruletypes.ExtractRuleTypes(page)
+	if err != nil {
+		return
+	}
+
+	fmt.Printf("%v <- Rule Types\n", rules)
+
+Example of Getting a QoS rule type by name
+
+	ruleTypeName := "bandwidth_limit"
+
+	ruleType, err := ruletypes.GetRuleType(networkClient, ruleTypeName).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, driver := range ruleType.Drivers {
+		fmt.Printf("%s: %+v\n", driver.Name, driver.SupportedParameters)
+	}
+*/
+package ruletypes
@@ -0,0 +1,79 @@
+package ruletypes
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// RuleType represents a single QoS rule type.
+type RuleType struct {
+	// Type is the name of the rule type, such as "bandwidth_limit".
+	Type string `json:"type"`
+
+	// Drivers are the QoS drivers supporting the rule type. They are only
+	// returned by GetRuleType.
+	Drivers []Driver `json:"drivers"`
+}
+
+// Driver represents a QoS driver supporting a rule type.
+type Driver struct {
+	// Name is the name of the driver.
+	Name string `json:"name"`
+
+	// SupportedParameters are the parameters of the rule type which are
+	// supported by the driver.
+	SupportedParameters []SupportedParameter `json:"supported_parameters"`
+}
+
+// SupportedParameter represents a parameter of a rule type supported by a
+// driver.
+type SupportedParameter struct {
+	// ParameterName is the name of the parameter, such as "max_kbps".
+	ParameterName string `json:"parameter_name"`
+
+	// ParameterType is the type of the allowed values, either "range" or
+	// "choices".
+	ParameterType string `json:"parameter_type"`
+
+	// ParameterValues are the allowed values. It is a map with "start" and
+	// "end" keys for a range, or a list of the allowed choices.
+	ParameterValues interface{} `json:"parameter_values"`
+}
+
+// GetResult represents the result of a GetRuleType operation. Call its
+// Extract method to interpret it as a RuleType.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a RuleType.
+func (r GetResult) Extract() (*RuleType, error) {
+	var s struct {
+		RuleType *RuleType `json:"rule_type"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RuleType, err
+}
+
+// ListRuleTypesPage contains a single page of all RuleTypes from a
+// ListRuleTypes call.
+type ListRuleTypesPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if a ListRuleTypesPage contains any results.
+func (r ListRuleTypesPage) IsEmpty() (bool, error) {
+	v, err := ExtractRuleTypes(r)
+	return len(v) == 0, err
+}
+
+// ExtractRuleTypes extracts and returns list of RuleTypes from a
+// ListRuleTypesPage.
+func ExtractRuleTypes(r pagination.Page) ([]RuleType, error) {
+	var s struct {
+		RuleTypes []RuleType `json:"rule_types"`
+	}
+
+	err := (r.(ListRuleTypesPage)).ExtractInto(&s)
+	return s.RuleTypes, err
+}
@@ -0,0 +1,2 @@
+// ruletypes unit tests
+package testing
@@ -0,0 +1,50 @@
+package testing
+
+const (
+	ListRuleTypesResponse = `
+{
+    "rule_types": [
+        {
+            "type": "bandwidth_limit"
+        },
+        {
+            "type": "dscp_marking"
+        },
+        {
+            "type": "minimum_bandwidth"
+        }
+    ]
+}
+`
+
+	GetRuleTypeResponse = `
+{
+    "rule_type": {
+        "type": "bandwidth_limit",
+        "drivers": [
+            {
+                "name": "openvswitch",
+                "supported_parameters": [
+                    {
+                        "parameter_values": {
+                            "start": 0,
+                            "end": 2147483647
+                        },
+                        "parameter_type": "range",
+                        "parameter_name": "max_kbps"
+                    },
+                    {
+                        "parameter_values": [
+                            "ingress",
+                            "egress"
+                        ],
+                        "parameter_type": "choices",
+                        "parameter_name": "direction"
+                    }
+                ]
+            }
+        ]
+    }
+}
+`
+)
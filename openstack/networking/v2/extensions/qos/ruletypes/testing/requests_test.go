@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/ruletypes"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestListRuleTypes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/rule-types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListRuleTypesResponse)
+	})
+
+	page, err := ruletypes.ListRuleTypes(fake.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	rules, err := ruletypes.ExtractRuleTypes(page)
+	th.AssertNoErr(t, err)
+
+	expected := []ruletypes.RuleType{{Type: "bandwidth_limit"}, {Type: "dscp_marking"}, {Type: "minimum_bandwidth"}}
+	th.AssertDeepEquals(t, expected, rules)
+}
+
+func TestGetRuleType(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/rule-types/bandwidth_limit", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetRuleTypeResponse)
+	})
+
+	r, err := ruletypes.GetRuleType(fake.ServiceClient(), "bandwidth_limit").Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &ruletypes.RuleType{
+		Type: "bandwidth_limit",
+		Drivers: []ruletypes.Driver{
+			{
+				Name: "openvswitch",
+				SupportedParameters: []ruletypes.SupportedParameter{
+					{
+						ParameterName: "max_kbps",
+						ParameterType: "range",
+						ParameterValues: map[string]interface{}{
+							"start": float64(0),
+							"end":   float64(2147483647),
+						},
+					},
+					{
+						ParameterName:   "direction",
+						ParameterType:   "choices",
+						ParameterValues: []interface{}{"ingress", "egress"},
+					},
+				},
+			},
+		},
+	}
+	th.AssertDeepEquals(t, expected, r)
+}
@@ -0,0 +1,11 @@
+package ruletypes
+
+import "github.com/gophercloud/gophercloud"
+
+func listRuleTypesURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("qos", "rule-types")
+}
+
+func getRuleTypeURL(c *gophercloud.ServiceClient, name string) string {
+	return c.ServiceURL("qos", "rule-types", name)
+}
@@ -0,0 +1,127 @@
+/*
+Package trunks provides the ability to retrieve and manage trunks through the
+Neutron API. Trunks allow an instance to send and receive VLAN-tagged traffic
+on a single parent port, by attaching subports to it with a segmentation.
+
+Example to List Trunks
+
+	listOpts := trunks.ListOpts{
+		Status: "ACTIVE",
+	}
+
+	allPages, err := trunks.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTrunks, err := trunks.ExtractTrunks(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, trunk := range allTrunks {
+		fmt.Printf("%+v\n", trunk)
+	}
+
+Example to Create a Trunk
+
+	iTrue := true
+	createOpts := trunks.CreateOpts{
+		Name:         "trunk",
+		Description:  "Trunk created by gophercloud",
+		AdminStateUp: &iTrue,
+		PortID:       "a6f0560c-b7a8-401f-bf6e-d0a5c851ae10",
+		Subports: []trunks.Subport{
+			{
+				SegmentationID:   1,
+				SegmentationType: "vlan",
+				PortID:           "bf4efcc0-b1c7-4674-81f0-31f58a33420a",
+			},
+		},
+	}
+
+	trunk, err := trunks.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Trunk
+
+	trunkID := "c36e7f2e-0c53-4742-8696-aee77c9df159"
+
+	name := "updated_trunk"
+	updateOpts := trunks.UpdateOpts{
+		Name: &name,
+	}
+
+	trunk, err := trunks.Update(networkClient, trunkID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Trunk
+
+	trunkID := "c36e7f2e-0c53-4742-8696-aee77c9df159"
+	err := trunks.Delete(networkClient, trunkID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get Subports of a Trunk
+
+	trunkID := "c36e7f2e-0c53-4742-8696-aee77c9df159"
+	subports, err := trunks.GetSubports(networkClient, trunkID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add Subports to a Trunk
+
+	trunkID := "c36e7f2e-0c53-4742-8696-aee77c9df159"
+	addSubportsOpts := trunks.AddSubportsOpts{
+		Subports: []trunks.Subport{
+			{
+				SegmentationID:   2,
+				SegmentationType: "vlan",
+				PortID:           "5e2d6ad5-d1d9-4a3b-8cb5-a5f69b58c2ae",
+			},
+		},
+	}
+
+	trunk, err := trunks.AddSubports(networkClient, trunkID, addSubportsOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove Subports from a Trunk
+
+	trunkID := "c36e7f2e-0c53-4742-8696-aee77c9df159"
+	removeSubportsOpts := trunks.RemoveSubportsOpts{
+		Subports: []trunks.RemoveSubport{
+			{PortID: "5e2d6ad5-d1d9-4a3b-8cb5-a5f69b58c2ae"},
+		},
+	}
+
+	trunk, err := trunks.RemoveSubports(networkClient, trunkID, removeSubportsOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Trunk Details of a Parent Port
+
+	var portWithTrunk struct {
+		ports.Port
+		trunks.TrunkDetailsExt
+	}
+
+	portID := "a6f0560c-b7a8-401f-bf6e-d0a5c851ae10"
+	err := ports.Get(networkClient, portID).ExtractInto(&portWithTrunk)
+	if err != nil {
+		panic(err)
+	}
+
+	if portWithTrunk.TrunkDetails != nil {
+		fmt.Printf("Trunk: %s\n", portWithTrunk.TrunkDetails.TrunkID)
+	}
+*/
+package trunks
@@ -0,0 +1,191 @@
+package trunks
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Subport represents a port which is attached to a trunk with a segmentation.
+type Subport struct {
+	SegmentationID   int    `json:"segmentation_id" required:"true"`
+	SegmentationType string `json:"segmentation_type" required:"true"`
+	PortID           string `json:"port_id" required:"true"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method
+// to interpret it as a Trunk.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Trunk.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request. Call its Extract method to
+// interpret it as a Trunk.
+type UpdateResult struct {
+	commonResult
+}
+
+// GetSubportsResult is the result of a GetSubports request. Call its Extract
+// method to interpret it as a slice of Subport.
+type GetSubportsResult struct {
+	gophercloud.Result
+}
+
+// UpdateSubportsResult is the result of an AddSubports or RemoveSubports
+// request. Call its Extract method to interpret it as a Trunk.
+type UpdateSubportsResult struct {
+	gophercloud.Result
+}
+
+// Trunk represents a trunk, which groups a parent port and its subports into
+// a single VLAN-aware port.
+type Trunk struct {
+	// Indicates whether the trunk is currently operational. Possible values include
+	// `ACTIVE', `DOWN', `BUILD', 'DEGRADED' or `ERROR'.
+	Status string `json:"status"`
+
+	// A list of ports associated with the trunk
+	Subports []Subport `json:"sub_ports"`
+
+	// Human-readable name for the trunk. Might not be unique.
+	Name string `json:"name,omitempty"`
+
+	// The administrative state of the trunk. If false (down), the trunk does not
+	// forward packets.
+	AdminStateUp bool `json:"admin_state_up,omitempty"`
+
+	// ProjectID is the project owner of the trunk.
+	ProjectID string `json:"project_id"`
+
+	// TenantID is the project owner of the trunk.
+	TenantID string `json:"tenant_id"`
+
+	// The date and time when the resource was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// The date and time when the resource was updated,
+	// if the resource has not been updated, this field will show as null.
+	UpdatedAt time.Time `json:"updated_at"`
+
+	RevisionNumber int `json:"revision_number"`
+
+	// UUID of the trunk's parent port
+	PortID string `json:"port_id"`
+
+	// UUID for the trunk resource
+	ID string `json:"id"`
+
+	// Display description.
+	Description string `json:"description"`
+
+	// A list of tags associated with the trunk
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Extract is a function that accepts a result and extracts a Trunk.
+func (r commonResult) Extract() (*Trunk, error) {
+	var s struct {
+		Trunk *Trunk `json:"trunk"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Trunk, err
+}
+
+// Extract is a function that accepts a result and extracts the subports.
+func (r GetSubportsResult) Extract() ([]Subport, error) {
+	var s struct {
+		Subports []Subport `json:"sub_ports"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Subports, err
+}
+
+// Extract is a function that accepts a result and extracts the updated Trunk.
+// Unlike the other results, the trunk is not wrapped in a "trunk" key.
+func (r UpdateSubportsResult) Extract() (t *Trunk, err error) {
+	err = r.ExtractInto(&t)
+	return
+}
+
+// TrunkPage is the page returned by a pager when traversing a collection of
+// trunk resources.
+type TrunkPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of trunks has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (page TrunkPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"trunks_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a TrunkPage struct is empty.
+func (page TrunkPage) IsEmpty() (bool, error) {
+	trunks, err := ExtractTrunks(page)
+	return len(trunks) == 0, err
+}
+
+// ExtractTrunks accepts a Page struct, specifically a TrunkPage struct,
+// and extracts the elements into a slice of Trunk structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractTrunks(page pagination.Page) ([]Trunk, error) {
+	var a []Trunk
+	err := ExtractTrunksInto(page, &a)
+	return a, err
+}
+
+// ExtractTrunksInto extracts the elements into a slice of Trunk structs.
+func ExtractTrunksInto(page pagination.Page, v interface{}) error {
+	return page.(TrunkPage).Result.ExtractIntoSlicePtr(v, "trunks")
+}
+
+// TrunkDetailsExt represents additional fields of a parent port of a trunk,
+// and is used to extract them from the results of the ports package.
+type TrunkDetailsExt struct {
+	// TrunkDetails contains details of any trunk associated with the port.
+	// It is nil if the port is not the parent port of a trunk.
+	TrunkDetails *TrunkDetails `json:"trunk_details,omitempty"`
+}
+
+// TrunkDetails contains the trunk of a parent port and its subports.
+type TrunkDetails struct {
+	// TrunkID is the UUID of the trunk.
+	TrunkID string `json:"trunk_id"`
+
+	// Subports is the list of subports of the trunk.
+	Subports []TrunkDetailsSubport `json:"sub_ports"`
+}
+
+// TrunkDetailsSubport is a Subport of a TrunkDetails, which also carries the
+// MAC address of the port.
+type TrunkDetailsSubport struct {
+	Subport
+
+	// MACAddress is the MAC address of the subport.
+	MACAddress string `json:"mac_address"`
+}
@@ -124,8 +124,9 @@ func Download(c *gophercloud.ServiceClient, containerName, objectName string, op
 	}
 
 	resp, err := c.Get(url, nil, &gophercloud.RequestOpts{
-		MoreHeaders: h,
-		OkCodes:     []int{200, 304},
+		MoreHeaders:      h,
+		OkCodes:          []int{200, 304},
+		KeepResponseBody: true,
 	})
 	if resp != nil {
 		r.Header = resp.Header
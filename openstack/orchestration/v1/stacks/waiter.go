@@ -0,0 +1,36 @@
+package stacks
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// NewWaiter returns a Waiter which polls a stack until its status is one of
+// target, such as CREATE_COMPLETE. A stack whose last action failed is a
+// failure. The Wait method of the Waiter returns the stack as a
+// *RetrievedStack.
+func NewWaiter(c *gophercloud.ServiceClient, stackName, stackID string, target ...string) *gophercloud.Waiter {
+	return &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			stack, err := Get(c.WithContext(ctx), stackName, stackID).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return stack, stack.Status, nil
+		},
+		Target: target,
+		Failure: []string{
+			"CREATE_FAILED",
+			"DELETE_FAILED",
+			"UPDATE_FAILED",
+			"ROLLBACK_FAILED",
+			"SUSPEND_FAILED",
+			"RESUME_FAILED",
+			"ADOPT_FAILED",
+			"SNAPSHOT_FAILED",
+			"CHECK_FAILED",
+			"RESTORE_FAILED",
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package shares
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// NewWaiter returns a Waiter which polls a share until its status is one of
+// target. The error statuses of a share are failures. The Wait method of the
+// Waiter returns the share as a *Share.
+func NewWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.Waiter {
+	return &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			share, err := Get(c.WithContext(ctx), id).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return share, share.Status, nil
+		},
+		Target: target,
+		Failure: []string{
+			"error",
+			"error_deleting",
+			"extending_error",
+			"shrinking_error",
+			"shrinking_possible_data_loss_error",
+			"manage_error",
+			"unmanage_error",
+		},
+	}
+}
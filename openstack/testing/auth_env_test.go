@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+var authEnvVars = []string{
+	"OS_AUTH_URL", "OS_USERNAME", "OS_USERID", "OS_PASSWORD",
+	"OS_TENANT_ID", "OS_TENANT_NAME", "OS_PROJECT_ID", "OS_PROJECT_NAME",
+	"OS_DOMAIN_ID", "OS_DOMAIN_NAME",
+	"OS_APPLICATION_CREDENTIAL_ID", "OS_APPLICATION_CREDENTIAL_NAME", "OS_APPLICATION_CREDENTIAL_SECRET",
+}
+
+func setAuthEnv(vars map[string]string) func() {
+	saved := make(map[string]string)
+	for _, k := range authEnvVars {
+		saved[k] = os.Getenv(k)
+		os.Unsetenv(k)
+	}
+	for k, v := range vars {
+		os.Setenv(k, v)
+	}
+	return func() {
+		for k, v := range saved {
+			os.Setenv(k, v)
+		}
+	}
+}
+
+func TestAuthOptionsFromEnvApplicationCredentialID(t *testing.T) {
+	defer setAuthEnv(map[string]string{
+		"OS_AUTH_URL":                      "http://identity.example.com:5000/v3",
+		"OS_APPLICATION_CREDENTIAL_ID":     "12345abcdef",
+		"OS_APPLICATION_CREDENTIAL_SECRET": "mysecret",
+	})()
+
+	ao, err := openstack.AuthOptionsFromEnv()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint:            "http://identity.example.com:5000/v3",
+		ApplicationCredentialID:     "12345abcdef",
+		ApplicationCredentialSecret: "mysecret",
+	}, ao)
+}
+
+func TestAuthOptionsFromEnvApplicationCredentialName(t *testing.T) {
+	defer setAuthEnv(map[string]string{
+		"OS_AUTH_URL":                      "http://identity.example.com:5000/v3",
+		"OS_APPLICATION_CREDENTIAL_NAME":   "myappcred",
+		"OS_APPLICATION_CREDENTIAL_SECRET": "mysecret",
+	})()
+
+	_, err := openstack.AuthOptionsFromEnv()
+	th.AssertDeepEquals(t, gophercloud.ErrMissingAnyoneOfEnvironmentVariables{
+		EnvironmentVariables: []string{"OS_USERNAME", "OS_USERID"},
+	}, err)
+
+	os.Setenv("OS_USERID", "12345")
+	ao, err := openstack.AuthOptionsFromEnv()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "myappcred", ao.ApplicationCredentialName)
+	th.AssertEquals(t, "12345", ao.UserID)
+}
+
+func TestAuthOptionsFromEnvApplicationCredentialMissingSecret(t *testing.T) {
+	defer setAuthEnv(map[string]string{
+		"OS_AUTH_URL":                  "http://identity.example.com:5000/v3",
+		"OS_APPLICATION_CREDENTIAL_ID": "12345abcdef",
+	})()
+
+	_, err := openstack.AuthOptionsFromEnv()
+	th.AssertDeepEquals(t, gophercloud.ErrMissingEnvironmentVariable{
+		EnvironmentVariable: "OS_APPLICATION_CREDENTIAL_SECRET",
+	}, err)
+}
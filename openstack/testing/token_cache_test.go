@@ -0,0 +1,110 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+type memoryTokenCache map[string]*gophercloud.CachedToken
+
+func (c memoryTokenCache) Load(key string) (*gophercloud.CachedToken, error) {
+	return c[key], nil
+}
+
+func (c memoryTokenCache) Store(key string, token *gophercloud.CachedToken) error {
+	c[key] = token
+	return nil
+}
+
+func (c memoryTokenCache) Delete(key string) error {
+	delete(c, key)
+	return nil
+}
+
+func TestAuthenticateV3TokenCache(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	var creates, validations int
+	valid := true
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			creates++
+			w.Header().Add("X-Subject-Token", fmt.Sprintf("token-%d", creates))
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{
+				"token": {
+					"expires_at": "%s",
+					"catalog": [{
+						"type": "compute",
+						"name": "nova",
+						"endpoints": [{
+							"interface": "public",
+							"region": "RegionOne",
+							"url": "https://compute.example.com/v2.1/"
+						}]
+					}]
+				}
+			}`, expiresAt.Format(time.RFC3339))
+		case "HEAD":
+			validations++
+			th.TestHeader(t, r, "X-Auth-Token", r.Header.Get("X-Subject-Token"))
+			if valid {
+				w.WriteHeader(http.StatusOK)
+			} else {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}
+	})
+
+	cache := memoryTokenCache{}
+	options := gophercloud.AuthOptions{
+		Username:         "me",
+		Password:         "secret",
+		DomainName:       "default",
+		TenantName:       "project",
+		IdentityEndpoint: th.Endpoint() + "v3/",
+	}
+
+	authenticate := func() *gophercloud.ProviderClient {
+		client, err := openstack.NewClient(options.IdentityEndpoint)
+		th.AssertNoErr(t, err)
+		client.TokenCache = cache
+		th.AssertNoErr(t, openstack.Authenticate(client, options))
+
+		url, err := client.EndpointLocator(gophercloud.EndpointOpts{Type: "compute", Availability: gophercloud.AvailabilityPublic})
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, "https://compute.example.com/v2.1/", url)
+		return client
+	}
+
+	client := authenticate()
+	th.CheckEquals(t, "token-1", client.TokenID)
+	th.CheckEquals(t, true, expiresAt.Equal(client.TokenExpiresAt))
+	th.CheckEquals(t, 1, creates)
+	th.CheckEquals(t, 0, validations)
+	th.CheckEquals(t, 1, len(cache))
+
+	// The cached token is validated and reused.
+	client = authenticate()
+	th.CheckEquals(t, "token-1", client.TokenID)
+	th.CheckEquals(t, 1, creates)
+	th.CheckEquals(t, 1, validations)
+
+	// An invalid token is replaced.
+	valid = false
+	client = authenticate()
+	th.CheckEquals(t, "token-2", client.TokenID)
+	th.CheckEquals(t, 2, creates)
+	th.CheckEquals(t, 2, validations)
+	th.CheckEquals(t, 1, len(cache))
+}
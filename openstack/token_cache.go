@@ -0,0 +1,111 @@
+package openstack
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	tokens3 "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+// reauthWindow returns how long before its expiry a token is replaced.
+func reauthWindow(client *gophercloud.ProviderClient) time.Duration {
+	if client.ReauthBeforeExpiry != 0 {
+		return client.ReauthBeforeExpiry
+	}
+	return gophercloud.DefaultReauthBeforeExpiry
+}
+
+// tokenExpiry returns the value of ProviderClient.TokenExpiresAt for a token
+// expiring at expiresAt. Tokens which look like they expire too soon, for
+// instance because the local clock is skewed, are not renewed proactively,
+// since their replacement would be renewed on every request.
+func tokenExpiry(client *gophercloud.ProviderClient, expiresAt time.Time) time.Time {
+	if time.Until(expiresAt) <= reauthWindow(client) {
+		return time.Time{}
+	}
+	return expiresAt
+}
+
+// v3TokenCacheKey derives the key of the tokens issued by the identity service
+// of v3Client for opts. The key is a hash, so that the credentials found in
+// opts do not leak into the cache.
+func v3TokenCacheKey(v3Client *gophercloud.ServiceClient, opts tokens3.AuthOptionsBuilder) (string, error) {
+	scope, err := opts.ToTokenV3ScopeMap()
+	if err != nil {
+		return "", err
+	}
+	b, err := opts.ToTokenV3CreateMap(scope)
+	if err != nil {
+		return "", err
+	}
+	j, err := json.Marshal(b)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(v3Client.Endpoint))
+	h.Write([]byte{0})
+	h.Write(j)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// loadV3Token returns the token stored under key in the cache of client, if
+// it does not expire soon and the identity service confirms it is still
+// valid. Invalid tokens are removed from the cache.
+func loadV3Token(client *gophercloud.ProviderClient, v3Client *gophercloud.ServiceClient, key string) (tokens3.CreateResult, bool) {
+	var r tokens3.CreateResult
+
+	cached, err := client.TokenCache.Load(key)
+	if err != nil || cached == nil {
+		return r, false
+	}
+	if time.Until(cached.ExpiresAt) <= reauthWindow(client) {
+		client.TokenCache.Delete(key)
+		return r, false
+	}
+
+	// Validate the token with itself. A separate provider is used, since the
+	// token of client may be missing or being replaced.
+	validator := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{
+			TokenID:     cached.ID,
+			HTTPClient:  client.HTTPClient,
+			UserAgent:   client.UserAgent,
+			RetryPolicy: client.RetryPolicy,
+			Context:     client.Context,
+		},
+		Endpoint: v3Client.Endpoint,
+		Type:     v3Client.Type,
+	}
+	if ok, err := tokens3.Validate(validator, cached.ID); err != nil || !ok {
+		client.TokenCache.Delete(key)
+		return r, false
+	}
+
+	if err := json.Unmarshal(cached.Body, &r.Body); err != nil {
+		client.TokenCache.Delete(key)
+		return r, false
+	}
+	r.Header = http.Header{}
+	r.Header.Set("X-Subject-Token", cached.ID)
+	return r, true
+}
+
+// storeV3Token stores the token issued in result under key in the cache of
+// client.
+func storeV3Token(client *gophercloud.ProviderClient, key string, result tokens3.CreateResult, token *tokens3.Token) {
+	body, err := json.Marshal(result.Body)
+	if err != nil {
+		return
+	}
+	client.TokenCache.Store(key, &gophercloud.CachedToken{
+		ID:        token.ID,
+		ExpiresAt: token.ExpiresAt,
+		Body:      body,
+	})
+}
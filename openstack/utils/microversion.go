@@ -0,0 +1,186 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// SupportedMicroversions is the range of microversions supported by a service.
+type SupportedMicroversions struct {
+	MinMajor int
+	MinMinor int
+	MaxMajor int
+	MaxMinor int
+}
+
+// Min returns the lowest supported microversion, such as "2.1".
+func (s SupportedMicroversions) Min() string {
+	return fmt.Sprintf("%d.%d", s.MinMajor, s.MinMinor)
+}
+
+// Max returns the highest supported microversion, such as "2.87".
+func (s SupportedMicroversions) Max() string {
+	return fmt.Sprintf("%d.%d", s.MaxMajor, s.MaxMinor)
+}
+
+// IsSupported reports whether the given microversion falls within the range.
+func (s SupportedMicroversions) IsSupported(version string) (bool, error) {
+	major, minor, err := ParseMicroversion(version)
+	if err != nil {
+		return false, err
+	}
+	return compareMicroversions(major, minor, s.MinMajor, s.MinMinor) >= 0 &&
+		compareMicroversions(major, minor, s.MaxMajor, s.MaxMinor) <= 0, nil
+}
+
+// ParseMicroversion parses a microversion of the form "X.Y".
+func ParseMicroversion(version string) (major int, minor int, err error) {
+	version = strings.TrimPrefix(version, "v")
+	parts := strings.Split(version, ".")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid microversion format: %q", version)
+	}
+	if major, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, fmt.Errorf("invalid microversion format: %q", version)
+	}
+	if minor, err = strconv.Atoi(parts[1]); err != nil {
+		return 0, 0, fmt.Errorf("invalid microversion format: %q", version)
+	}
+	return major, minor, nil
+}
+
+func compareMicroversions(major1, minor1, major2, minor2 int) int {
+	switch {
+	case major1 != major2:
+		return major1 - major2
+	default:
+		return minor1 - minor2
+	}
+}
+
+var endpointVersionRe = regexp.MustCompile(`/v([0-9]+)(\.[0-9]+)?(/|$)`)
+
+// GetSupportedMicroversions queries the version document of the service of
+// client, and returns the range of microversions supported by the API
+// version the client uses.
+//
+// Compute, block storage and shared file systems report the range as the
+// min_version and version of their current API version. The load balancer
+// service lists each of its minor versions instead.
+func GetSupportedMicroversions(client *gophercloud.ServiceClient) (SupportedMicroversions, error) {
+	var s SupportedMicroversions
+
+	endpoint := client.ResourceBaseURL()
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return s, err
+	}
+
+	loc := endpointVersionRe.FindStringSubmatchIndex(u.Path)
+	if loc == nil {
+		return s, fmt.Errorf("no API version found in endpoint %s", endpoint)
+	}
+	major, err := strconv.Atoi(u.Path[loc[2]:loc[3]])
+	if err != nil {
+		return s, err
+	}
+
+	// The version document is served at the root of the service.
+	u.Path = u.Path[:loc[0]+1]
+	u.RawQuery, u.Fragment = "", ""
+
+	type version struct {
+		ID         string `json:"id"`
+		Version    string `json:"version"`
+		MinVersion string `json:"min_version"`
+	}
+	var resp struct {
+		Versions []version `json:"versions"`
+	}
+	_, err = client.Request("GET", u.String(), &gophercloud.RequestOpts{
+		JSONResponse: &resp,
+		OkCodes:      []int{200, 300},
+	})
+	if err != nil {
+		return s, err
+	}
+
+	found := false
+	for _, v := range resp.Versions {
+		idMajor, idMinor, err := ParseMicroversion(v.ID)
+		if err != nil || idMajor != major {
+			continue
+		}
+
+		if v.Version != "" {
+			// A single API version with a range of microversions.
+			maxMajor, maxMinor, err := ParseMicroversion(v.Version)
+			if err != nil {
+				return s, err
+			}
+			minMajor, minMinor := idMajor, idMinor
+			if v.MinVersion != "" {
+				if minMajor, minMinor, err = ParseMicroversion(v.MinVersion); err != nil {
+					return s, err
+				}
+			}
+			return SupportedMicroversions{
+				MinMajor: minMajor,
+				MinMinor: minMinor,
+				MaxMajor: maxMajor,
+				MaxMinor: maxMinor,
+			}, nil
+		}
+
+		// One entry per minor version.
+		if !found || compareMicroversions(idMajor, idMinor, s.MinMajor, s.MinMinor) < 0 {
+			s.MinMajor, s.MinMinor = idMajor, idMinor
+		}
+		if !found || compareMicroversions(idMajor, idMinor, s.MaxMajor, s.MaxMinor) > 0 {
+			s.MaxMajor, s.MaxMinor = idMajor, idMinor
+		}
+		found = true
+	}
+
+	if !found {
+		return s, fmt.Errorf("no microversions of API version %d found at %s", major, u.String())
+	}
+	return s, nil
+}
+
+// NegotiateMicroversion picks the highest microversion supported by both the
+// service of client and the caller, whose newest known microversion is
+// maxVersion, and sets it as the Microversion of client. Use "latest" as
+// maxVersion to pick the highest microversion of the service. An error is
+// returned if the service does not support any microversion up to
+// maxVersion.
+//
+//	mv, err := utils.NegotiateMicroversion(computeClient, "2.79")
+func NegotiateMicroversion(client *gophercloud.ServiceClient, maxVersion string) (string, error) {
+	supported, err := GetSupportedMicroversions(client)
+	if err != nil {
+		return "", err
+	}
+
+	microversion := supported.Max()
+	if maxVersion != "latest" {
+		major, minor, err := ParseMicroversion(maxVersion)
+		if err != nil {
+			return "", err
+		}
+		if compareMicroversions(major, minor, supported.MinMajor, supported.MinMinor) < 0 {
+			return "", fmt.Errorf("microversion %s is older than the oldest supported microversion %s", maxVersion, supported.Min())
+		}
+		if compareMicroversions(major, minor, supported.MaxMajor, supported.MaxMinor) < 0 {
+			microversion = fmt.Sprintf("%d.%d", major, minor)
+		}
+	}
+
+	client.Microversion = microversion
+	return microversion, nil
+}
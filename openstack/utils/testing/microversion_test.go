@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const computeVersions = `
+{
+	"versions": [
+		{
+			"id": "v2.0",
+			"status": "SUPPORTED",
+			"version": "",
+			"min_version": ""
+		},
+		{
+			"id": "v2.1",
+			"status": "CURRENT",
+			"version": "2.87",
+			"min_version": "2.1"
+		}
+	]
+}
+`
+
+const blockStorageVersions = `
+{
+	"versions": [
+		{
+			"id": "v2.0",
+			"status": "DEPRECATED",
+			"version": "",
+			"min_version": ""
+		},
+		{
+			"id": "v3.0",
+			"status": "CURRENT",
+			"version": "3.64",
+			"min_version": "3.0"
+		}
+	]
+}
+`
+
+const loadBalancerVersions = `
+{
+	"versions": [
+		{"id": "v2.0", "status": "SUPPORTED"},
+		{"id": "v2.1", "status": "SUPPORTED"},
+		{"id": "v2.2", "status": "CURRENT"}
+	]
+}
+`
+
+func handleVersions(t *testing.T, path, body string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, body)
+	})
+}
+
+func serviceClient(endpoint, resourceBase string) *gophercloud.ServiceClient {
+	sc := client.ServiceClient()
+	sc.Endpoint = th.Endpoint() + endpoint
+	if resourceBase != "" {
+		sc.ResourceBase = th.Endpoint() + resourceBase
+	}
+	return sc
+}
+
+func TestGetSupportedMicroversions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleVersions(t, "/compute/", computeVersions)
+	handleVersions(t, "/volume/", blockStorageVersions)
+	handleVersions(t, "/load-balancer/", loadBalancerVersions)
+
+	tests := []struct {
+		endpoint     string
+		resourceBase string
+		expected     utils.SupportedMicroversions
+	}{
+		{"compute/v2.1/", "", utils.SupportedMicroversions{MinMajor: 2, MinMinor: 1, MaxMajor: 2, MaxMinor: 87}},
+		{"volume/v3/0123456789abcdef/", "", utils.SupportedMicroversions{MinMajor: 3, MinMinor: 0, MaxMajor: 3, MaxMinor: 64}},
+		{"load-balancer/", "load-balancer/v2.0/", utils.SupportedMicroversions{MinMajor: 2, MinMinor: 0, MaxMajor: 2, MaxMinor: 2}},
+	}
+
+	for _, tt := range tests {
+		supported, err := utils.GetSupportedMicroversions(serviceClient(tt.endpoint, tt.resourceBase))
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, tt.expected, supported)
+	}
+}
+
+func TestNegotiateMicroversion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleVersions(t, "/compute/", computeVersions)
+
+	sc := serviceClient("compute/v2.1/", "")
+
+	mv, err := utils.NegotiateMicroversion(sc, "2.53")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2.53", mv)
+	th.AssertEquals(t, "2.53", sc.Microversion)
+
+	mv, err = utils.NegotiateMicroversion(sc, "2.100")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2.87", mv)
+
+	mv, err = utils.NegotiateMicroversion(sc, "latest")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2.87", mv)
+
+	_, err = utils.NegotiateMicroversion(sc, "1.5")
+	if err == nil {
+		t.Fatal("Expected an error for an unsupported microversion")
+	}
+}
+
+func TestSupportedMicroversionsIsSupported(t *testing.T) {
+	s := utils.SupportedMicroversions{MinMajor: 2, MinMinor: 1, MaxMajor: 2, MaxMinor: 87}
+
+	for version, expected := range map[string]bool{
+		"2.0":  false,
+		"2.1":  true,
+		"2.53": true,
+		"2.87": true,
+		"2.88": false,
+		"3.1":  false,
+	} {
+		supported, err := s.IsSupported(version)
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, expected, supported)
+	}
+
+	_, err := s.IsSupported("latest")
+	if err == nil {
+		t.Fatal("Expected an error for an invalid microversion")
+	}
+}
@@ -1,6 +1,7 @@
 package pagination
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -67,8 +68,16 @@ func (p Pager) WithPageCreator(createPage func(r PageResult) Page) Pager {
 	}
 }
 
-func (p Pager) fetchNextPage(url string) (Page, error) {
-	resp, err := Request(p.client, p.Headers, url)
+// fetchNextPage retrieves the page at url. A nil ctx leaves the choice of
+// context to the service client.
+func (p Pager) fetchNextPage(ctx context.Context, url string) (Page, error) {
+	var resp *http.Response
+	var err error
+	if ctx != nil {
+		resp, err = RequestWithContext(ctx, p.client, p.Headers, url)
+	} else {
+		resp, err = Request(p.client, p.Headers, url)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -84,12 +93,28 @@ func (p Pager) fetchNextPage(url string) (Page, error) {
 // EachPage iterates over each page returned by a Pager, yielding one at a time to a handler function.
 // Return "false" from the handler to prematurely stop iterating.
 func (p Pager) EachPage(handler func(Page) (bool, error)) error {
+	return p.eachPage(nil, handler)
+}
+
+// EachPageWithContext is like EachPage, but every page request is bound to ctx. Iteration stops with
+// ctx's error once ctx is done.
+func (p Pager) EachPageWithContext(ctx context.Context, handler func(Page) (bool, error)) error {
+	return p.eachPage(ctx, handler)
+}
+
+func (p Pager) eachPage(ctx context.Context, handler func(Page) (bool, error)) error {
 	if p.Err != nil {
 		return p.Err
 	}
 	currentURL := p.initialURL
 	for {
-		currentPage, err := p.fetchNextPage(currentURL)
+		if ctx != nil {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+
+		currentPage, err := p.fetchNextPage(ctx, currentURL)
 		if err != nil {
 			return err
 		}
@@ -123,13 +148,22 @@ func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 // AllPages returns all the pages from a `List` operation in a single page,
 // allowing the user to retrieve all the pages at once.
 func (p Pager) AllPages() (Page, error) {
+	return p.allPages(nil)
+}
+
+// AllPagesWithContext is like AllPages, but every page request is bound to ctx.
+func (p Pager) AllPagesWithContext(ctx context.Context) (Page, error) {
+	return p.allPages(ctx)
+}
+
+func (p Pager) allPages(ctx context.Context) (Page, error) {
 	// pagesSlice holds all the pages until they get converted into as Page Body.
 	var pagesSlice []interface{}
 	// body will contain the final concatenated Page body.
 	var body reflect.Value
 
 	// Grab a test page to ascertain the page body type.
-	testPage, err := p.fetchNextPage(p.initialURL)
+	testPage, err := p.fetchNextPage(ctx, p.initialURL)
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +183,7 @@ func (p Pager) AllPages() (Page, error) {
 		// key is the map key for the page body if the body type is `map[string]interface{}`.
 		var key string
 		// Iterate over the pages to concatenate the bodies.
-		err = p.EachPage(func(page Page) (bool, error) {
+		err = p.eachPage(ctx, func(page Page) (bool, error) {
 			b := page.GetBody().(map[string]interface{})
 			for k, v := range b {
 				// If it's a linked page, we don't want the `links`, we want the other one.
@@ -172,7 +206,7 @@ func (p Pager) AllPages() (Page, error) {
 		body.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(pagesSlice))
 	case []byte:
 		// Iterate over the pages to concatenate the bodies.
-		err = p.EachPage(func(page Page) (bool, error) {
+		err = p.eachPage(ctx, func(page Page) (bool, error) {
 			b := page.GetBody().([]byte)
 			pagesSlice = append(pagesSlice, b)
 			// seperate pages with a comma
@@ -196,7 +230,7 @@ func (p Pager) AllPages() (Page, error) {
 		body.SetBytes(b)
 	case []interface{}:
 		// Iterate over the pages to concatenate the bodies.
-		err = p.EachPage(func(page Page) (bool, error) {
+		err = p.eachPage(ctx, func(page Page) (bool, error) {
 			b := page.GetBody().([]interface{})
 			pagesSlice = append(pagesSlice, b...)
 			return true, nil
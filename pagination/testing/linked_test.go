@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -110,3 +111,40 @@ func TestAllPagesLinked(t *testing.T) {
 	testhelper.AssertNoErr(t, err)
 	testhelper.CheckDeepEquals(t, expected, actual)
 }
+
+func TestEachPageWithContextLinked(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	callCount := 0
+	err := pager.EachPageWithContext(ctx, func(page pagination.Page) (bool, error) {
+		callCount++
+		cancel()
+		return true, nil
+	})
+	testhelper.AssertEquals(t, context.Canceled, err)
+	testhelper.AssertEquals(t, 1, callCount)
+}
+
+func TestAllPagesWithContextLinked(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	page, err := pager.AllPagesWithContext(context.Background())
+	testhelper.AssertNoErr(t, err)
+
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	actual, err := ExtractLinkedInts(page)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, expected, actual)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = pager.AllPagesWithContext(ctx)
+	if err == nil {
+		t.Fatalf("Expected an error from a cancelled context")
+	}
+}
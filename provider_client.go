@@ -2,17 +2,23 @@ package gophercloud
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 )
 
 // DefaultUserAgent is the default User-Agent string set in the request header.
 const DefaultUserAgent = "gophercloud/2.0.0"
 
+// DefaultReauthBeforeExpiry is the default value of
+// ProviderClient.ReauthBeforeExpiry.
+const DefaultReauthBeforeExpiry = time.Minute
+
 // UserAgent represents a User-Agent header.
 type UserAgent struct {
 	// prepend is the slice of User-Agent strings to prepend to DefaultUserAgent.
@@ -56,6 +62,20 @@ type ProviderClient struct {
 	// To safely read or write this value, call `Token` or `SetToken`, respectively
 	TokenID string
 
+	// TokenExpiresAt is the expiry time of the token in TokenID, if known.
+	// NOTE: Like TokenID, this field should only be set by a ReauthFunc.
+	TokenExpiresAt time.Time
+
+	// ReauthBeforeExpiry is how long before TokenExpiresAt the client
+	// reauthenticates on its own, instead of waiting for the token to be
+	// rejected with a 401. It defaults to DefaultReauthBeforeExpiry.
+	ReauthBeforeExpiry time.Duration
+
+	// TokenCache, if set, allows authentication to reuse a valid token which
+	// was issued earlier, possibly to another process, and to store the
+	// tokens it obtains. See FileTokenCache.
+	TokenCache TokenCache
+
 	// EndpointLocator describes how this provider discovers the endpoints for
 	// its constituent services.
 	EndpointLocator EndpointLocator
@@ -71,6 +91,24 @@ type ProviderClient struct {
 	// authentication functions for different Identity service versions.
 	ReauthFunc func() error
 
+	// RetryPolicy, if set, is consulted whenever a request fails with a
+	// transport error or an unexpected response code, and decides whether
+	// and when the request is issued again. See BackoffRetryPolicy.
+	RetryPolicy RetryPolicy
+
+	// DisableKeepAlives, if set, closes the connection after every request
+	// instead of keeping it open to be reused by the next request.
+	DisableKeepAlives bool
+
+	// RequestHooks are notified of every HTTP request issued by this client,
+	// and of its response. See RequestHook.
+	RequestHooks []RequestHook
+
+	// Context is the context passed to every HTTP request issued by this
+	// client, unless a request supplies its own Context in RequestOpts.
+	// Cancelling it aborts in-flight requests.
+	Context context.Context
+
 	mut *sync.RWMutex
 
 	reauthmut *reauthlock
@@ -156,6 +194,51 @@ func (client *ProviderClient) Reauthenticate(previousToken string) (err error) {
 	return
 }
 
+// tokenExpiresSoon reports whether the current token is about to expire and
+// should be replaced before issuing another request.
+func (client *ProviderClient) tokenExpiresSoon() bool {
+	if client.ReauthFunc == nil {
+		return false
+	}
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	if client.TokenID == "" || client.TokenExpiresAt.IsZero() {
+		return false
+	}
+
+	window := client.ReauthBeforeExpiry
+	if window == 0 {
+		window = DefaultReauthBeforeExpiry
+	}
+	return time.Now().Add(window).After(client.TokenExpiresAt)
+}
+
+// reauthenticate calls Reauthenticate, but stops waiting for it as soon as ctx
+// is done. The reauthentication itself is left to finish in the background so
+// that concurrent requests sharing the same token are not affected.
+func (client *ProviderClient) reauthenticate(ctx context.Context, previousToken string) error {
+	if ctx == nil {
+		return client.Reauthenticate(previousToken)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- client.Reauthenticate(previousToken)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // RequestOpts customizes the behavior of the provider.Request() method.
 type RequestOpts struct {
 	// JSONBody, if provided, will be encoded as JSON and used as the body of the HTTP request. The
@@ -178,6 +261,20 @@ type RequestOpts struct {
 	// ErrorContext specifies the resource error type to return if an error is encountered.
 	// This lets resources override default error messages based on the response status code.
 	ErrorContext error
+	// Context, if provided, is attached to the HTTP request and takes precedence over the
+	// ProviderClient's Context. It is also honoured while reauthenticating after a 401.
+	Context context.Context
+	// Microversion, if provided, is the microversion requested from the service. The methods of
+	// ServiceClient send it in the service's microversion headers, overriding the Microversion of
+	// the ServiceClient for this request only.
+	Microversion string
+	// KeepResponseBody, if true, leaves the body of a successful response without a JSONResponse
+	// open for the caller to stream and close. By default such a body is read into memory and
+	// closed, so that the connection can be reused.
+	KeepResponseBody bool
+	// ServiceType is the type of the service client issuing the request. ServiceClient sets it.
+	// It is reported to the ProviderClient's RequestHooks along with the Microversion.
+	ServiceType string
 }
 
 var applicationJSON = "application/json"
@@ -206,6 +303,14 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 
 	if options.RawBody != nil {
 		body = options.RawBody
+
+		// The transport closes the request body once it has been sent, which
+		// would prevent a seekable body from being rewound for a retry.
+		if _, ok := body.(io.Seeker); ok && client.RetryPolicy != nil {
+			if _, ok := body.(io.Closer); ok {
+				body = ioutil.NopCloser(body)
+			}
+		}
 	}
 
 	// Construct the http.Request.
@@ -214,6 +319,23 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 		return nil, err
 	}
 
+	ctx := options.Context
+	if ctx == nil {
+		ctx = client.Context
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	// Replace a token which is about to expire. Should this fail, the current
+	// token is still used, and the request reauthenticates again if the token
+	// is rejected.
+	if client.tokenExpiresSoon() {
+		if err := client.reauthenticate(ctx, client.Token()); err != nil && ctx != nil && err == ctx.Err() {
+			return nil, err
+		}
+	}
+
 	// Populate the request headers. Apply options.MoreHeaders last, to give the caller the chance to
 	// modify or omit any header.
 	if contentType != nil {
@@ -239,31 +361,49 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 		req.Header.Set(k, v)
 	}
 
-	// Set connection parameter to close the connection immediately when we've got the response
-	req.Close = true
+	// Connections are kept alive and reused unless the client opted out.
+	req.Close = client.DisableKeepAlives
 
 	prereqtok := req.Header.Get("X-Auth-Token")
 
-	// Issue the request.
-	resp, err := client.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Allow default OkCodes if none explicitly set
 	if options.OkCodes == nil {
 		options.OkCodes = defaultOkCodes(method)
 	}
 
-	// Validate the HTTP response status.
-	var ok bool
-	for _, code := range options.OkCodes {
-		if resp.StatusCode == code {
-			ok = true
+	// Issue the request, retrying it as long as the retry policy allows.
+	info := RequestInfo{
+		ServiceType:  options.ServiceType,
+		Microversion: options.Microversion,
+		Attempt:      1,
+	}
+	resp, err := client.do(req, info)
+	for attempt := 1; client.RetryPolicy != nil; attempt++ {
+		if err == nil && isOkCode(resp.StatusCode, options.OkCodes) {
+			break
+		}
+		delay, retry := client.RetryPolicy.ShouldRetry(method, attempt, resp, err)
+		if !retry || !rewindBody(req, options.RawBody) {
 			break
 		}
+		if resp != nil {
+			drainAndClose(resp.Body)
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+		retryReq := *req
+		req = &retryReq
+		info.Attempt = attempt + 1
+		resp, err = client.do(req, info)
+	}
+	if err != nil {
+		return nil, err
 	}
 
+	// Validate the HTTP response status.
+	ok := isOkCode(resp.StatusCode, options.OkCodes)
+
 	if !ok {
 		body, _ := ioutil.ReadAll(resp.Body)
 		resp.Body.Close()
@@ -273,6 +413,10 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 			Expected: options.OkCodes,
 			Actual:   resp.StatusCode,
 			Body:     body,
+
+			ResponseHeader: resp.Header,
+			RequestID:      requestID(resp.Header),
+			Fault:          parseFault(body),
 		}
 
 		errType := options.ErrorContext
@@ -284,8 +428,11 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 			}
 		case http.StatusUnauthorized:
 			if client.ReauthFunc != nil {
-				err = client.Reauthenticate(prereqtok)
+				err = client.reauthenticate(ctx, prereqtok)
 				if err != nil {
+					if ctx != nil && err == ctx.Err() {
+						return nil, err
+					}
 					e := &ErrUnableToReauthenticate{}
 					e.ErrOriginal = respErr
 					return nil, e
@@ -360,15 +507,40 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 
 	// Parse the response body as JSON, if requested to do so.
 	if options.JSONResponse != nil {
-		defer resp.Body.Close()
+		defer drainAndClose(resp.Body)
 		if err := json.NewDecoder(resp.Body).Decode(options.JSONResponse); err != nil {
 			return nil, err
 		}
+	} else if !options.KeepResponseBody {
+		// Nobody is going to close the body of a response without a JSONResponse, so read it
+		// now to release the connection, and keep a copy for callers that inspect it.
+		buf, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(buf))
 	}
 
 	return resp, nil
 }
 
+// drainAndClose reads what is left of a response body before closing it, so
+// that the connection it was received on can be reused.
+func drainAndClose(body io.ReadCloser) {
+	io.Copy(ioutil.Discard, body)
+	body.Close()
+}
+
+func isOkCode(code int, okCodes []int) bool {
+	for _, c := range okCodes {
+		if code == c {
+			return true
+		}
+	}
+	return false
+}
+
 func defaultOkCodes(method string) []int {
 	switch {
 	case method == "GET":
@@ -0,0 +1,215 @@
+package gophercloud
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides whether a failed request should be issued again, and
+// how long to wait before doing so. Set ProviderClient.RetryPolicy to enable
+// retries for every service client that shares the provider.
+type RetryPolicy interface {
+	// ShouldRetry is called after the attempt-th attempt (starting from 1) of
+	// a request failed, either with a response whose status code is not
+	// accepted by the request (resp is non-nil), or with a transport error
+	// (resp is nil and err is non-nil). It returns the delay to wait before
+	// the next attempt, and whether another attempt should be made at all.
+	ShouldRetry(method string, attempt int, resp *http.Response, err error) (time.Duration, bool)
+}
+
+// Default values used by BackoffRetryPolicy when the corresponding field is
+// left empty.
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryBaseDelay   = 500 * time.Millisecond
+	DefaultRetryMaxDelay    = 30 * time.Second
+)
+
+// BackoffRetryPolicy is a RetryPolicy which retries requests rejected with
+// 429, 502, 503 or 504 as well as transient transport errors, waiting an
+// exponentially increasing, jittered delay between attempts. A Retry-After
+// header sent with the response takes precedence over the computed delay.
+//
+// Requests which were rejected with a 429 are always retried, since the
+// service did not process them. Other failures may have happened after the
+// service acted on the request, so they are only retried for idempotent
+// methods (GET, HEAD, OPTIONS, PUT and DELETE) unless RetryNonIdempotent is
+// set.
+//
+// Example:
+//
+//	provider.RetryPolicy = &gophercloud.BackoffRetryPolicy{
+//		MaxAttempts: 5,
+//		MaxDelay:    time.Minute,
+//	}
+type BackoffRetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a request,
+	// including the first one. It defaults to DefaultRetryMaxAttempts.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the second attempt. It doubles for every
+	// following attempt. It defaults to DefaultRetryBaseDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts. A Retry-After header
+	// asking to wait longer than MaxDelay stops the retries. It defaults to
+	// DefaultRetryMaxDelay.
+	MaxDelay time.Duration
+
+	// RetryNonIdempotent allows POST and PATCH requests to be retried after
+	// a 5xx response or a transport error.
+	RetryNonIdempotent bool
+}
+
+// ShouldRetry implements RetryPolicy.
+func (p *BackoffRetryPolicy) ShouldRetry(method string, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	maxAttempts := p.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultRetryMaxAttempts
+	}
+	if attempt >= maxAttempts {
+		return 0, false
+	}
+
+	if resp != nil {
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests:
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			if !p.RetryNonIdempotent && !isIdempotent(method) {
+				return 0, false
+			}
+		default:
+			return 0, false
+		}
+	} else if !isTransientError(err) || (!p.RetryNonIdempotent && !isIdempotent(method)) {
+		return 0, false
+	}
+
+	maxDelay := p.MaxDelay
+	if maxDelay == 0 {
+		maxDelay = DefaultRetryMaxDelay
+	}
+
+	if resp != nil {
+		if delay, ok := ParseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if delay > maxDelay {
+				return 0, false
+			}
+			return delay, true
+		}
+	}
+
+	delay := p.BaseDelay
+	if delay == 0 {
+		delay = DefaultRetryBaseDelay
+	}
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	// Use "equal jitter": wait at least half of the computed delay, plus a
+	// random amount up to the other half.
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1)), true
+}
+
+// ParseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date. It returns false if the value is empty
+// or cannot be parsed.
+func ParseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		delay := time.Until(t)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// isTransientError reports whether a transport error is worth retrying.
+// Cancelled or expired contexts are never retried.
+func isTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if uerr, ok := err.(*url.Error); ok {
+		err = uerr.Err
+	}
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return false
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	_, ok := err.(net.Error)
+	return ok
+}
+
+// rewindBody prepares req to be sent again, returning false if its body cannot
+// be read a second time.
+func rewindBody(req *http.Request, rawBody io.Reader) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return false
+		}
+		req.Body = body
+		return true
+	}
+	if seeker, ok := rawBody.(io.Seeker); ok {
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// sleepContext waits for d, returning early with the context's error if ctx is
+// done first. A nil ctx never expires.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+
+	select {
+	case <-t.C:
+		return nil
+	case <-done:
+		return ctx.Err()
+	}
+}
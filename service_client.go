@@ -1,6 +1,7 @@
 package gophercloud
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -32,6 +33,39 @@ type ServiceClient struct {
 	// MoreHeaders allows users (or Gophercloud) to set service-wide headers on requests. Put another way,
 	// values set in this field will be set on all the HTTP requests the service client sends.
 	MoreHeaders map[string]string
+
+	// Context, if set, is attached to every request issued through this service client that does not
+	// carry its own RequestOpts.Context. It takes precedence over the ProviderClient's Context.
+	// Use WithContext to obtain a copy of the service client bound to a particular context.
+	Context context.Context
+}
+
+// WithContext returns a shallow copy of the service client whose requests are bound to ctx. The copy
+// shares the underlying ProviderClient, so tokens obtained through reauthentication are seen by both.
+// Every resource package accepts the returned client, which makes it possible to cancel or set a
+// deadline on any call:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	server, err := servers.Get(client.WithContext(ctx), "{serverId}").Extract()
+func (client *ServiceClient) WithContext(ctx context.Context) *ServiceClient {
+	if ctx == nil {
+		panic("nil context")
+	}
+	c := *client
+	c.Context = ctx
+	return &c
+}
+
+// WithMicroversion returns a shallow copy of the service client whose requests use the given
+// microversion. It allows a single call to opt into a newer microversion without changing the
+// microversion of a client shared with other callers:
+//
+//	server, err := servers.Get(client.WithMicroversion("2.79"), "{serverId}").Extract()
+func (client *ServiceClient) WithMicroversion(microversion string) *ServiceClient {
+	c := *client
+	c.Microversion = microversion
+	return &c
 }
 
 // ResourceBaseURL returns the base URL of any resources used by this service. It MUST end with a /.
@@ -62,7 +96,10 @@ func (client *ServiceClient) initReqOpts(url string, JSONBody interface{}, JSONR
 		opts.MoreHeaders = make(map[string]string)
 	}
 
-	if client.Microversion != "" {
+	if opts.Microversion == "" {
+		opts.Microversion = client.Microversion
+	}
+	if opts.Microversion != "" {
 		client.setMicroversionHeader(opts)
 	}
 }
@@ -124,27 +161,39 @@ func (client *ServiceClient) Head(url string, opts *RequestOpts) (*http.Response
 func (client *ServiceClient) setMicroversionHeader(opts *RequestOpts) {
 	switch client.Type {
 	case "compute":
-		opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+		opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = opts.Microversion
 	case "sharev2":
-		opts.MoreHeaders["X-OpenStack-Manila-API-Version"] = client.Microversion
+		opts.MoreHeaders["X-OpenStack-Manila-API-Version"] = opts.Microversion
 	case "volume":
-		opts.MoreHeaders["X-OpenStack-Volume-API-Version"] = client.Microversion
+		opts.MoreHeaders["X-OpenStack-Volume-API-Version"] = opts.Microversion
 	}
 
 	if client.Type != "" {
-		opts.MoreHeaders["OpenStack-API-Version"] = client.Type + " " + client.Microversion
+		opts.MoreHeaders["OpenStack-API-Version"] = client.Type + " " + opts.Microversion
 	}
 }
 
 // Request carries out the HTTP operation for the service client
 func (client *ServiceClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	if options == nil {
+		options = new(RequestOpts)
+	}
 	if len(client.MoreHeaders) > 0 {
-		if options == nil {
-			options = new(RequestOpts)
+		if options.MoreHeaders == nil {
+			options.MoreHeaders = make(map[string]string)
 		}
 		for k, v := range client.MoreHeaders {
 			options.MoreHeaders[k] = v
 		}
 	}
+	if options.Context == nil {
+		options.Context = client.Context
+	}
+	if options.ServiceType == "" {
+		options.ServiceType = client.Type
+	}
+	if options.Microversion == "" {
+		options.Microversion = client.Microversion
+	}
 	return client.ProviderClient.Request(method, url, options)
 }
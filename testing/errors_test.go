@@ -0,0 +1,116 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestErrorRequestIDAndFault(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Compute-Request-Id", "req-1234")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"itemNotFound": {"message": "Instance foo could not be found.", "code": 404}}`)
+	})
+
+	p := &gophercloud.ProviderClient{}
+	_, err := p.Request("GET", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+
+	if !errors.Is(err, gophercloud.ErrDefault404{}) {
+		t.Fatalf("Expected ErrDefault404, got %#v", err)
+	}
+	th.AssertEquals(t, false, errors.Is(err, gophercloud.ErrDefault400{}))
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusNotFound))
+	th.AssertEquals(t, false, gophercloud.ResponseCodeIs(err, http.StatusConflict))
+
+	var e gophercloud.ErrUnexpectedResponseCode
+	th.AssertEquals(t, true, errors.As(err, &e))
+	th.AssertEquals(t, http.StatusNotFound, e.Actual)
+	th.AssertEquals(t, "req-1234", e.RequestID)
+	th.AssertEquals(t, "req-1234", e.ResponseHeader.Get("X-Compute-Request-Id"))
+	th.AssertDeepEquals(t, &gophercloud.Fault{
+		Type:    "itemNotFound",
+		Code:    404,
+		Message: "Instance foo could not be found.",
+	}, e.Fault)
+
+	if !strings.Contains(e.Error(), "Request ID: req-1234") {
+		t.Fatalf("Expected the request ID in %q", e.Error())
+	}
+}
+
+func TestErrorAfterReauthenticationUnwraps(t *testing.T) {
+	err := error(&gophercloud.ErrErrorAfterReauthentication{
+		ErrOriginal: gophercloud.ErrDefault403{
+			ErrUnexpectedResponseCode: gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusForbidden},
+		},
+	})
+
+	th.AssertEquals(t, true, errors.Is(err, gophercloud.ErrDefault403{}))
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusForbidden))
+
+	var e gophercloud.ErrDefault403
+	th.AssertEquals(t, true, errors.As(err, &e))
+}
+
+func TestErrorFaults(t *testing.T) {
+	tests := []struct {
+		body  string
+		fault *gophercloud.Fault
+	}{
+		{
+			`{"badRequest": {"message": "Invalid flavorRef provided.", "code": 400}}`,
+			&gophercloud.Fault{Type: "badRequest", Code: 400, Message: "Invalid flavorRef provided."},
+		},
+		{
+			`{"NeutronError": {"type": "NetworkNotFound", "message": "Network foo could not be found.", "detail": ""}}`,
+			&gophercloud.Fault{Type: "NetworkNotFound", Message: "Network foo could not be found."},
+		},
+		{
+			`{"error": {"code": 404, "title": "Not Found", "message": "Could not find project: foo."}}`,
+			&gophercloud.Fault{Type: "Not Found", Code: 404, Message: "Could not find project: foo."},
+		},
+		{
+			`{"explanation": "The resource could not be found.", "code": 404, "error": {"message": "The Stack (foo) could not be found.", "traceback": null, "type": "EntityNotFound"}, "title": "Not Found"}`,
+			&gophercloud.Fault{Type: "EntityNotFound", Code: 404, Message: "The Stack (foo) could not be found."},
+		},
+		{
+			`{"faultcode": "Client", "faultstring": "Load Balancer foo not found.", "debuginfo": null}`,
+			&gophercloud.Fault{Type: "Client", Message: "Load Balancer foo not found."},
+		},
+		{
+			`{"errors": [{"status": 409, "title": "Conflict", "detail": "Resource provider has allocations."}]}`,
+			&gophercloud.Fault{Type: "Conflict", Code: 409, Message: "Resource provider has allocations."},
+		},
+		{`<html><body>Not Found</body></html>`, nil},
+		{`{"foo": "bar"}`, nil},
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var body string
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, body)
+	})
+
+	p := &gophercloud.ProviderClient{}
+	for _, tt := range tests {
+		body = tt.body
+		_, err := p.Request("GET", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+
+		var e gophercloud.ErrUnexpectedResponseCode
+		th.AssertEquals(t, true, errors.As(err, &e))
+		th.CheckDeepEquals(t, tt.fault, e.Fault)
+	}
+}
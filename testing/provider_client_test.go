@@ -1,10 +1,15 @@
 package testing
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -122,3 +127,230 @@ func TestConcurrentReauth(t *testing.T) {
 
 	th.AssertEquals(t, 1, info.numreauths)
 }
+
+func TestRequestWithContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "OK")
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &gophercloud.ProviderClient{Context: ctx}
+
+	res, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	_, err = ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	th.AssertNoErr(t, err)
+
+	cancel()
+	res, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	}
+	if !strings.Contains(err.Error(), ctx.Err().Error()) {
+		t.Fatalf("expecting error to contain: %q, got %q", ctx.Err().Error(), err.Error())
+	}
+
+	// A context set in RequestOpts takes precedence over the client's one.
+	res, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{Context: context.Background()})
+	th.AssertNoErr(t, err)
+	res.Body.Close()
+}
+
+func TestRequestReauthWithCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reauthStarted := make(chan struct{})
+	reauthRelease := make(chan struct{})
+	defer close(reauthRelease)
+
+	p := new(gophercloud.ProviderClient)
+	p.UseTokenLock()
+	p.SetToken(client.TokenID)
+	p.ReauthFunc = func() error {
+		close(reauthStarted)
+		<-reauthRelease
+		return nil
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	go func() {
+		<-reauthStarted
+		cancel()
+	}()
+
+	_, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &gophercloud.RequestOpts{Context: ctx})
+	th.AssertEquals(t, context.Canceled, err)
+}
+
+func TestRequestReauthBeforeExpiry(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", "new-token")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	reauths := 0
+	p := &gophercloud.ProviderClient{
+		TokenID:        "old-token",
+		TokenExpiresAt: time.Now().Add(30 * time.Second),
+	}
+	p.UseTokenLock()
+	p.ReauthFunc = func() error {
+		reauths++
+		p.TokenID = "new-token"
+		p.TokenExpiresAt = time.Now().Add(time.Hour)
+		return nil
+	}
+
+	_, err := p.Request("GET", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, reauths)
+
+	// The new token is far from expiring and is used as is.
+	_, err = p.Request("GET", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, reauths)
+}
+
+// newConnCountingServer starts a server which counts the connections it
+// accepts. It serves a JSON document on /json, a 404 with a fault on /missing,
+// and an invalid JSON document on /invalid.
+func newConnCountingServer(tls bool) (*httptest.Server, *int32) {
+	var conns int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, "{\"foo\": \"bar\"}\n\n")
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"itemNotFound": {"message": "Not found", "code": 404}}`)
+	})
+	mux.HandleFunc("/invalid", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"foo": }`+strings.Repeat(" ", 8192))
+	})
+	mux.HandleFunc("/resource", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, "accepted")
+	})
+
+	ts := httptest.NewUnstartedServer(mux)
+	ts.Config.ConnState = func(c net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&conns, 1)
+		}
+	}
+	if tls {
+		ts.StartTLS()
+	} else {
+		ts.Start()
+	}
+	return ts, &conns
+}
+
+func TestRequestReusesConnections(t *testing.T) {
+	ts, conns := newConnCountingServer(false)
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		HTTPClient: http.Client{Transport: &http.Transport{}},
+	}
+	for i := 0; i < 3; i++ {
+		var body map[string]string
+		_, err := p.Request("GET", ts.URL+"/json", &gophercloud.RequestOpts{JSONResponse: &body})
+		th.AssertNoErr(t, err)
+
+		_, err = p.Request("GET", ts.URL+"/missing", &gophercloud.RequestOpts{JSONResponse: &body})
+		th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusNotFound))
+
+		_, err = p.Request("GET", ts.URL+"/invalid", &gophercloud.RequestOpts{JSONResponse: &body})
+		if err == nil {
+			t.Fatal("Expected a JSON decoding error")
+		}
+	}
+	th.AssertEquals(t, int32(1), atomic.LoadInt32(conns))
+
+	// The previous behaviour can be restored.
+	atomic.StoreInt32(conns, 0)
+	p = &gophercloud.ProviderClient{
+		HTTPClient:        http.Client{Transport: &http.Transport{}},
+		DisableKeepAlives: true,
+	}
+	for i := 0; i < 3; i++ {
+		_, err := p.Request("GET", ts.URL+"/json", &gophercloud.RequestOpts{JSONResponse: new(interface{})})
+		th.AssertNoErr(t, err)
+	}
+	th.AssertEquals(t, int32(3), atomic.LoadInt32(conns))
+}
+
+func TestRequestWithoutJSONResponseReusesConnections(t *testing.T) {
+	ts, conns := newConnCountingServer(false)
+	defer ts.Close()
+
+	sc := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{
+			HTTPClient: http.Client{Transport: &http.Transport{}},
+		},
+		Endpoint: ts.URL + "/",
+	}
+	for i := 0; i < 20; i++ {
+		_, err := sc.Delete(sc.ServiceURL("resource"), nil)
+		th.AssertNoErr(t, err)
+	}
+	th.AssertEquals(t, int32(1), atomic.LoadInt32(conns))
+
+	// The body is still available to callers that inspect it.
+	resp, err := sc.Delete(sc.ServiceURL("resource"), nil)
+	th.AssertNoErr(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "accepted", string(body))
+
+	// A kept body is left open for the caller to stream.
+	resp, err = sc.Get(sc.ServiceURL("json"), nil, &gophercloud.RequestOpts{KeepResponseBody: true})
+	th.AssertNoErr(t, err)
+	body, err = ioutil.ReadAll(resp.Body)
+	th.AssertNoErr(t, err)
+	resp.Body.Close()
+	th.AssertEquals(t, "{\"foo\": \"bar\"}\n\n", string(body))
+}
+
+func benchmarkRequest(b *testing.B, disableKeepAlives bool) {
+	ts, _ := newConnCountingServer(true)
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		HTTPClient:        *ts.Client(),
+		DisableKeepAlives: disableKeepAlives,
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var body map[string]string
+		_, err := p.Request("GET", ts.URL+"/json", &gophercloud.RequestOpts{JSONResponse: &body})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRequestKeepAlive(b *testing.B) {
+	benchmarkRequest(b, false)
+}
+
+func BenchmarkRequestDisableKeepAlives(b *testing.B) {
+	benchmarkRequest(b, true)
+}
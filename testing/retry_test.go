@@ -0,0 +1,174 @@
+package testing
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	d, ok := gophercloud.ParseRetryAfter("")
+	th.AssertEquals(t, false, ok)
+
+	d, ok = gophercloud.ParseRetryAfter("120")
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 120*time.Second, d)
+
+	d, ok = gophercloud.ParseRetryAfter("-1")
+	th.AssertEquals(t, false, ok)
+
+	d, ok = gophercloud.ParseRetryAfter("Wed, 21 Oct 2015 07:28:00 GMT")
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, time.Duration(0), d)
+
+	d, ok = gophercloud.ParseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	th.AssertEquals(t, true, ok)
+	if d <= 59*time.Minute || d > time.Hour {
+		t.Fatalf("Unexpected delay: %s", d)
+	}
+
+	_, ok = gophercloud.ParseRetryAfter("soon")
+	th.AssertEquals(t, false, ok)
+}
+
+func TestBackoffRetryPolicy(t *testing.T) {
+	p := &gophercloud.BackoffRetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    300 * time.Millisecond,
+	}
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	for attempt, max := range []time.Duration{100, 200, 300} {
+		d, ok := p.ShouldRetry("POST", attempt+1, resp, nil)
+		th.AssertEquals(t, true, ok)
+		if d < max*time.Millisecond/2 || d > max*time.Millisecond {
+			t.Fatalf("Attempt %d: unexpected delay %s", attempt+1, d)
+		}
+	}
+	_, ok := p.ShouldRetry("POST", 4, resp, nil)
+	th.AssertEquals(t, false, ok)
+
+	resp.Header.Set("Retry-After", "0")
+	d, ok := p.ShouldRetry("GET", 1, resp, nil)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, time.Duration(0), d)
+
+	resp.Header.Set("Retry-After", "60")
+	_, ok = p.ShouldRetry("GET", 1, resp, nil)
+	th.AssertEquals(t, false, ok)
+
+	resp = &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	_, ok = p.ShouldRetry("GET", 1, resp, nil)
+	th.AssertEquals(t, true, ok)
+	_, ok = p.ShouldRetry("POST", 1, resp, nil)
+	th.AssertEquals(t, false, ok)
+
+	resp = &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{}}
+	_, ok = p.ShouldRetry("GET", 1, resp, nil)
+	th.AssertEquals(t, false, ok)
+
+	_, ok = p.ShouldRetry("GET", 1, nil, io.ErrUnexpectedEOF)
+	th.AssertEquals(t, true, ok)
+	_, ok = p.ShouldRetry("PATCH", 1, nil, io.ErrUnexpectedEOF)
+	th.AssertEquals(t, false, ok)
+
+	p.RetryNonIdempotent = true
+	_, ok = p.ShouldRetry("PATCH", 1, nil, io.ErrUnexpectedEOF)
+	th.AssertEquals(t, true, ok)
+}
+
+func TestRequestRetry(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var attempts int
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		th.CheckEquals(t, `{"foo":"bar"}`, string(body))
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.BackoffRetryPolicy{},
+	}
+	_, err := p.Request("POST", th.Endpoint()+"route", &gophercloud.RequestOpts{
+		JSONBody: map[string]string{"foo": "bar"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, attempts)
+}
+
+func TestRequestRetryExhausted(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var attempts int
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.BackoffRetryPolicy{MaxAttempts: 2},
+	}
+	_, err := p.Request("GET", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault503); !ok {
+		t.Fatalf("Expected ErrDefault503, got %#v", err)
+	}
+	th.AssertEquals(t, 2, attempts)
+
+	// POST is not idempotent, so a 503 is not retried.
+	attempts = 0
+	_, err = p.Request("POST", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault503); !ok {
+		t.Fatalf("Expected ErrDefault503, got %#v", err)
+	}
+	th.AssertEquals(t, 1, attempts)
+}
+
+// seekableReader hides the concrete type of its reader from net/http, so that
+// the request body can only be rewound by seeking.
+type seekableReader struct {
+	io.ReadSeeker
+}
+
+func TestRequestRetryRewindsRawBody(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var attempts int
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		th.CheckEquals(t, "raw data", string(body))
+		if attempts < 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.BackoffRetryPolicy{},
+	}
+	_, err := p.Request("PUT", th.Endpoint()+"route", &gophercloud.RequestOpts{
+		RawBody: seekableReader{strings.NewReader("raw data")},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, attempts)
+}
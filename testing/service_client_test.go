@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -32,3 +33,66 @@ func TestMoreHeaders(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, resp.Request.Header.Get("custom"), "header")
 }
+
+func TestWithContext(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := new(gophercloud.ServiceClient)
+	c.ProviderClient = new(gophercloud.ProviderClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cc := c.WithContext(ctx)
+	th.AssertEquals(t, c.ProviderClient, cc.ProviderClient)
+
+	resp, err := cc.Get(fmt.Sprintf("%s/route", th.Endpoint()), nil, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ctx, resp.Request.Context())
+
+	cancel()
+	_, err = cc.Get(fmt.Sprintf("%s/route", th.Endpoint()), nil, nil)
+	if err == nil {
+		t.Fatal("expecting error from a cancelled context, got nil")
+	}
+
+	// The original client is not bound to the cancelled context.
+	_, err = c.Get(fmt.Sprintf("%s/route", th.Endpoint()), nil, nil)
+	th.AssertNoErr(t, err)
+}
+
+func TestMicroversionOverride(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var received []string
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("X-OpenStack-Nova-API-Version"))
+		th.CheckEquals(t, "compute "+received[len(received)-1], r.Header.Get("OpenStack-API-Version"))
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := &gophercloud.ServiceClient{
+		ProviderClient: new(gophercloud.ProviderClient),
+		Type:           "compute",
+		Microversion:   "2.1",
+	}
+
+	_, err := c.Get(th.Endpoint()+"route", nil, nil)
+	th.AssertNoErr(t, err)
+
+	_, err = c.Get(th.Endpoint()+"route", nil, &gophercloud.RequestOpts{Microversion: "2.60"})
+	th.AssertNoErr(t, err)
+
+	_, err = c.WithMicroversion("2.79").Get(th.Endpoint()+"route", nil, nil)
+	th.AssertNoErr(t, err)
+
+	// The shared client is left untouched.
+	th.AssertEquals(t, "2.1", c.Microversion)
+	_, err = c.Get(th.Endpoint()+"route", nil, nil)
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []string{"2.1", "2.60", "2.79", "2.1"}, received)
+}
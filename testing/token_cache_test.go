@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestFileTokenCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gophercloud-tokens")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	cache := gophercloud.NewFileTokenCache(filepath.Join(dir, "tokens"))
+
+	token, err := cache.Load("key")
+	th.AssertNoErr(t, err)
+	if token != nil {
+		t.Fatalf("Expected no token, got %#v", token)
+	}
+
+	expected := &gophercloud.CachedToken{
+		ID:        "0123456789",
+		ExpiresAt: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+		Body:      json.RawMessage(`{"token":{"catalog":[]}}`),
+	}
+	th.AssertNoErr(t, cache.Store("key", expected))
+
+	info, err := os.Stat(filepath.Join(dir, "tokens", "key.json"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, os.FileMode(0600), info.Mode().Perm())
+
+	token, err = cache.Load("key")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, token)
+
+	th.AssertNoErr(t, cache.Delete("key"))
+	th.AssertNoErr(t, cache.Delete("key"))
+
+	token, err = cache.Load("key")
+	th.AssertNoErr(t, err)
+	if token != nil {
+		t.Fatalf("Expected no token, got %#v", token)
+	}
+}
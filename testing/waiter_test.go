@@ -0,0 +1,148 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// stateSequence returns a StateRefreshFunc which returns each of the given
+// states in turn, then repeats the last one. An empty state stands for a 404.
+func stateSequence(states ...string) (gophercloud.StateRefreshFunc, *int) {
+	var calls int
+	return func(ctx context.Context) (interface{}, string, error) {
+		state := states[calls]
+		if calls < len(states)-1 {
+			calls++
+		}
+		if state == "" {
+			return nil, "", gophercloud.ErrDefault404{
+				ErrUnexpectedResponseCode: gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusNotFound},
+			}
+		}
+		return "resource", state, nil
+	}, &calls
+}
+
+func TestWaiter(t *testing.T) {
+	refresh, calls := stateSequence("BUILD", "BUILD", "active")
+
+	var elapsed []time.Duration
+	w := &gophercloud.Waiter{
+		Refresh:     refresh,
+		Target:      []string{"ACTIVE"},
+		Failure:     []string{"ERROR"},
+		Interval:    time.Millisecond,
+		MaxInterval: 2 * time.Millisecond,
+		Progress: func(state string, e time.Duration) {
+			elapsed = append(elapsed, e)
+		},
+	}
+
+	resource, err := w.Wait(context.Background())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "resource", resource)
+	th.AssertEquals(t, 2, *calls)
+	th.AssertEquals(t, 3, len(elapsed))
+	if elapsed[2]-elapsed[1] < 2*time.Millisecond {
+		t.Fatalf("Expected the interval to double, got %v", elapsed)
+	}
+}
+
+func TestWaiterFailureState(t *testing.T) {
+	refresh, _ := stateSequence("BUILD", "ERROR")
+	w := &gophercloud.Waiter{
+		Refresh:  refresh,
+		Target:   []string{"ACTIVE"},
+		Failure:  []string{"ERROR"},
+		Interval: time.Millisecond,
+	}
+
+	resource, err := w.Wait(context.Background())
+	th.AssertEquals(t, "resource", resource)
+	th.AssertDeepEquals(t, gophercloud.ErrWaitFailure{State: "ERROR", Resource: "resource"}, err)
+}
+
+func TestWaiterDeleted(t *testing.T) {
+	refresh, _ := stateSequence("DELETING", "")
+	w := &gophercloud.Waiter{
+		Refresh:  refresh,
+		Target:   []string{gophercloud.StateDeleted},
+		Interval: time.Millisecond,
+	}
+	_, err := w.Wait(context.Background())
+	th.AssertNoErr(t, err)
+
+	// A resource which disappears while waiting for another state is a
+	// failure.
+	refresh, _ = stateSequence("BUILD", "")
+	w = &gophercloud.Waiter{
+		Refresh:  refresh,
+		Target:   []string{"ACTIVE"},
+		Interval: time.Millisecond,
+	}
+	_, err = w.Wait(context.Background())
+	e, ok := err.(gophercloud.ErrWaitFailure)
+	if !ok {
+		t.Fatalf("Expected ErrWaitFailure, got %#v", err)
+	}
+	th.AssertEquals(t, gophercloud.StateDeleted, e.State)
+	th.AssertEquals(t, nil, e.Resource)
+
+	// The 404 the resource disappeared with is kept, so that it can still be
+	// matched.
+	if _, ok := e.Err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("Expected the ErrDefault404 to be kept, got %#v", e.Err)
+	}
+	th.AssertEquals(t, true, errors.Is(err, gophercloud.ErrDefault404{}))
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusNotFound))
+}
+
+func TestWaiterRefreshError(t *testing.T) {
+	w := &gophercloud.Waiter{
+		Refresh: func(ctx context.Context) (interface{}, string, error) {
+			return nil, "", fmt.Errorf("boom")
+		},
+		Target: []string{"ACTIVE"},
+	}
+	_, err := w.Wait(context.Background())
+	th.AssertEquals(t, "boom", err.Error())
+}
+
+func TestWaiterTimeout(t *testing.T) {
+	refresh, _ := stateSequence("BUILD")
+	w := &gophercloud.Waiter{
+		Refresh:  refresh,
+		Target:   []string{"ACTIVE"},
+		Timeout:  20 * time.Millisecond,
+		Interval: 5 * time.Millisecond,
+	}
+
+	_, err := w.Wait(context.Background())
+	if _, ok := err.(gophercloud.ErrTimeOut); !ok {
+		t.Fatalf("Expected ErrTimeOut, got %#v", err)
+	}
+	th.AssertEquals(t, "Timed out after 20ms waiting for state ACTIVE; last state was BUILD", err.Error())
+}
+
+func TestWaiterContext(t *testing.T) {
+	refresh, _ := stateSequence("BUILD")
+	w := &gophercloud.Waiter{
+		Refresh:  refresh,
+		Target:   []string{"ACTIVE"},
+		Timeout:  time.Minute,
+		Interval: 5 * time.Millisecond,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := w.Wait(ctx)
+	th.AssertEquals(t, context.DeadlineExceeded, err)
+}
@@ -0,0 +1,118 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TokenCache stores tokens between authentications, so that a token issued
+// to a previous ProviderClient, possibly in another process, can be reused
+// instead of requesting a new one. Set ProviderClient.TokenCache before
+// authenticating to enable it. Only tokens issued by the v3 identity service
+// are cached.
+//
+// Keys are opaque strings derived from the identity endpoint and the
+// credentials used to authenticate. Errors returned by a TokenCache are not
+// fatal: authentication falls back to requesting a new token.
+type TokenCache interface {
+	// Load returns the token stored under key, or nil if there is none.
+	Load(key string) (*CachedToken, error)
+
+	// Store stores token under key, replacing any previous token.
+	Store(key string, token *CachedToken) error
+
+	// Delete removes the token stored under key, if any.
+	Delete(key string) error
+}
+
+// CachedToken is a token stored in a TokenCache.
+type CachedToken struct {
+	// ID is the token itself.
+	ID string `json:"id"`
+
+	// ExpiresAt is the time at which the token expires.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Body is the body of the response which issued the token. It holds the
+	// service catalog, among other details.
+	Body json.RawMessage `json:"body"`
+}
+
+// FileTokenCache is a TokenCache which stores every token in its own file
+// inside a directory. Files are only readable by their owner, since tokens
+// grant access to the cloud just like passwords do.
+type FileTokenCache struct {
+	// Dir is the directory holding the cached tokens. It is created on the
+	// first Store if it does not exist.
+	Dir string
+}
+
+// NewFileTokenCache returns a FileTokenCache storing its tokens in dir.
+func NewFileTokenCache(dir string) *FileTokenCache {
+	return &FileTokenCache{Dir: dir}
+}
+
+func (c *FileTokenCache) path(key string) string {
+	return filepath.Join(c.Dir, key+".json")
+}
+
+// Load implements TokenCache.
+func (c *FileTokenCache) Load(key string) (*CachedToken, error) {
+	b, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var token CachedToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Store implements TokenCache. The token is written to a temporary file which
+// is then renamed, so that concurrent readers never see a partial token.
+func (c *FileTokenCache) Store(key string, token *CachedToken) error {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.Dir, 0700); err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(c.Dir, key+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), c.path(key)); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return nil
+}
+
+// Delete implements TokenCache.
+func (c *FileTokenCache) Delete(key string) error {
+	err := os.Remove(c.path(key))
+	if err != nil && os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -14,6 +14,7 @@ import (
 // predicate will be prematurely cancelled after the timeout.
 // Resource packages will wrap this in a more convenient function that's
 // specific to a certain resource, but it can also be useful on its own.
+// Waiter offers more control, such as a context, backoff and failure states.
 func WaitFor(timeout int, predicate func() (bool, error)) error {
 	type WaitForResult struct {
 		Success bool
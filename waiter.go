@@ -0,0 +1,146 @@
+package gophercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StateDeleted is the state of a resource which can no longer be found. The
+// Waiter enters it when refreshing the resource fails with a 404.
+const StateDeleted = "DELETED"
+
+// Default values used by Waiter when the corresponding field is left empty.
+const (
+	DefaultWaitInterval    = time.Second
+	DefaultWaitMaxInterval = 10 * time.Second
+)
+
+// StateRefreshFunc fetches the current state of the resource a Waiter waits
+// for, and returns the resource along with its state. The context must be
+// attached to the requests it issues, for instance with
+// ServiceClient.WithContext.
+type StateRefreshFunc func(ctx context.Context) (resource interface{}, state string, err error)
+
+// Waiter polls a resource until it reaches one of a set of states. Resource
+// packages provide ready-made waiters, such as servers.NewWaiter, whose
+// fields may be adjusted before calling Wait:
+//
+//	waiter := servers.NewWaiter(client, serverID, "ACTIVE")
+//	waiter.Timeout = 10 * time.Minute
+//	waiter.Progress = func(state string, elapsed time.Duration) {
+//		log.Printf("server is %s after %s", state, elapsed)
+//	}
+//	_, err := waiter.Wait(ctx)
+type Waiter struct {
+	// Refresh fetches the current state of the resource.
+	Refresh StateRefreshFunc
+
+	// Target are the states to wait for. States are compared without regard
+	// to case. Use StateDeleted to wait for the resource to be deleted.
+	Target []string
+
+	// Failure are the states from which the resource will never reach one
+	// of the Target states. Reaching one of them stops the wait with an
+	// ErrWaitFailure. A resource which is deleted while StateDeleted is not
+	// one of the Target states is also a failure, which wraps the error the
+	// resource was refreshed with.
+	Failure []string
+
+	// Timeout, if set, limits how long Wait polls the resource before it
+	// returns an ErrTimeOut.
+	Timeout time.Duration
+
+	// Interval is the delay between the first two polls. It doubles after
+	// every poll, up to MaxInterval. Set both to the same value to poll at a
+	// fixed rate. They default to DefaultWaitInterval and
+	// DefaultWaitMaxInterval.
+	Interval    time.Duration
+	MaxInterval time.Duration
+
+	// Progress, if set, is called after every poll with the current state
+	// of the resource and the time elapsed since Wait was called.
+	Progress func(state string, elapsed time.Duration)
+}
+
+// Wait polls the resource until it reaches one of the Target states, and
+// returns it as last fetched by Refresh. It stops early if the resource
+// reaches a Failure state, if Refresh fails, if the Timeout elapses, or if
+// ctx is done.
+func (w *Waiter) Wait(ctx context.Context) (interface{}, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	start := time.Now()
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, start.Add(w.Timeout))
+		defer cancel()
+	}
+
+	interval := w.Interval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+	maxInterval := w.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxInterval
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+
+	var state string
+	for {
+		resource, current, err := w.Refresh(ctx)
+		if err != nil {
+			if !ResponseCodeIs(err, 404) {
+				return nil, w.timeoutError(ctx, start, state, err)
+			}
+			resource, current = nil, StateDeleted
+		}
+		state = current
+
+		if w.Progress != nil {
+			w.Progress(state, time.Since(start))
+		}
+
+		if containsState(w.Target, state) {
+			return resource, nil
+		}
+		if strings.EqualFold(state, StateDeleted) || containsState(w.Failure, state) {
+			return resource, ErrWaitFailure{State: state, Resource: resource, Err: err}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, w.timeoutError(ctx, start, state, err)
+		}
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// timeoutError returns an ErrTimeOut in place of err if the wait was stopped
+// by its own Timeout rather than by the caller's context.
+func (w *Waiter) timeoutError(ctx context.Context, start time.Time, state string, err error) error {
+	if w.Timeout <= 0 || ctx.Err() != context.DeadlineExceeded || time.Since(start) < w.Timeout {
+		return err
+	}
+	e := ErrTimeOut{}
+	e.Info = fmt.Sprintf("Timed out after %s waiting for state %s; last state was %s",
+		w.Timeout, strings.Join(w.Target, " or "), state)
+	return e
+}
+
+func containsState(states []string, state string) bool {
+	for _, s := range states {
+		if strings.EqualFold(s, state) {
+			return true
+		}
+	}
+	return false
+}